@@ -2,8 +2,12 @@ var conn
 var nodes = {}
 var nodes_by_id = []
 var mainchart
+var mainlatchart
 var charts = {}
+var latcharts = {}
 var nodeinfo = []
+var CLUSTERKEY = "*cluster*" // Node name of cluster-wide series
+var nodeerrors = {} // Latest error counts by node name
 
 // Clear out the log counter for this node.
 function clearCounter(id) {
@@ -12,14 +16,38 @@ function clearCounter(id) {
   counter.toggleClass('hidden', true)
 }
 
-// Broadcast this command
-function tellEveryone(which) {
-  var len = nodes_by_id.length
-  for (var i = 0; i < len; i++) {
-    if (nodes_by_id[i]) { // Skip deleted ones
-      conn.send(which + " " + nodes_by_id[i].name)
+// Charts drawn in a hidden tab need resizing once it's shown.
+function reflowLatency(id) {
+  setTimeout(function() {
+    if (latcharts[id]) {
+      latcharts[id].reflow()
     }
+  }, 10)
+}
+
+// Send a command to the cluster: to the node called target, or every
+// node if that's empty. Commands this node refuses come back as ERRORs
+// with the same id; the node gives what it sends on an ID of its own.
+var lastid = 0
+function send(type, target, payload) {
+  var msg = {v: 1, type: type, id: "ui-" + (++lastid)}
+  if (target) {
+    msg.target = target
   }
+  if (typeof payload !== 'undefined') {
+    msg.payload = payload
+  }
+  conn.send(JSON.stringify(msg))
+}
+
+// The nodes the address box picks: names or labels, or "*" for all.
+function address() {
+  return $("#address").val().trim() || "*"
+}
+
+// Send this command to the addressed nodes
+function tellEveryone(which) {
+  send(which, address())
 }
 
 // Handle per-node play/stop button toggling.
@@ -29,45 +57,367 @@ function buttonPlayPress(id) {
   if(button.hasClass('btn-success')) { // Playing, send stop.
     cmd = "STOP"
   }
-  conn.send(cmd + " " + nodes_by_id[id].name)
+  send(cmd, nodes_by_id[id].name)
 }
 
-// Tell all nodes to STOP or START using this collection
+// Tell the addressed nodes to STOP or START using this collection
 function collState(coll, state) {
-  var len = nodes_by_id.length
-  for (var i = 0; i < len; i++) {
-    if (nodes_by_id[i]) { // Skip deleted ones
-      conn.send("COLL" + state + " " + nodes_by_id[i].name + " " + coll)
-    }
-  }
+  send("COLL" + state, address(), coll)
 }
 
 // Toggle the state of this collection
 function toggleColl(button, host, coll) {
   if($(button).hasClass('btn-success')) { // active, deactivate
-    conn.send("COLLSTOP " + host + " " + coll)
+    send("COLLSTOP", host, coll)
   } else {
-    conn.send("COLLSTART " + host + " " + coll)
+    send("COLLSTART", host, coll)
   }
 }
 
-// Kills all running nodes.
+// Kills the addressed nodes, or the one given.
 function die(id) {
-  var name = "all"
+  var name = address()
   if (typeof id !== 'undefined') {
     name = nodes_by_id[id].name
   }
-  conn.send("DIE " + name)
+  send("DIE", name)
 }
 
 function changedb(sel) {
-  conn.send("DB " + sel.value)
+  send("DB", "", sel.value)
+  editTarget(sel.value)
+}
+
+// The DB targets by name, passwords hidden.
+var dbtargets = {}
+
+// Fetch the DB targets and refill the DB menu.
+function loadTargets() {
+  $.getJSON("dbtargets/", function(data) {
+    var sel = $("#whichdb").empty()
+    dbtargets = {}
+    data.targets.forEach(function(t) {
+      dbtargets[t.name] = t
+      sel.append($("<option>").val(t.name).text(t.label || t.name))
+    })
+    sel.val(data.current)
+    editTarget(data.current)
+  })
+}
+
+function editTarget(name) {
+  var t = dbtargets[name] || {}
+  $("#targetname").val(name)
+  $("#targeturi").val(t.uri)
+  $("#targetdb").val(t.database)
+  $("#targetauth").val(t.authmechanism || "")
+  $("#targetpool").val(t.poolsize || 0)
+  $("#targettls").prop("checked", !!t.tls)
+  $("#targetinsecure").prop("checked", !!t.tlsinsecure)
+  $("#targetca").val(t.tlscafile)
+}
+
+// The target in the form, as JSON.
+function formTarget() {
+  var name = $("#targetname").val()
+  return JSON.stringify({
+    label: (dbtargets[name] || {}).label,
+    uri: $("#targeturi").val(),
+    database: $("#targetdb").val(),
+    authmechanism: $("#targetauth").val(),
+    poolsize: Number($("#targetpool").val()) || 0,
+    tls: $("#targettls").prop("checked"),
+    tlsinsecure: $("#targetinsecure").prop("checked"),
+    tlscafile: $("#targetca").val()
+  })
+}
+
+function targetURL(suffix) {
+  return "dbtargets/" + encodeURIComponent($("#targetname").val()) + (suffix || "")
+}
+
+function targetFailed(xhr) {
+  $("#targetstatus").addClass('errorcount').text(xhr.responseText)
+}
+
+function saveTarget() {
+  $.ajax({url: targetURL(), type: "PUT", data: formTarget(), contentType: "application/json"})
+    .done(function() {
+      $("#targetstatus").removeClass('errorcount').text("Saved " + $("#targetname").val())
+    })
+    .fail(targetFailed)
+}
+
+function testTarget() {
+  $("#targetstatus").removeClass('errorcount').text("Connecting...")
+  $.ajax({url: targetURL("/test"), type: "POST", data: formTarget(), contentType: "application/json"})
+    .done(function(r) {
+      var box = $("#targetstatus").toggleClass('errorcount', !r.ok)
+      if (r.ok) {
+        box.text(r.name + " OK from " + r.node + " in " + Math.round(r.millis) + "ms, Mongo " +
+                 r.version + ", " + r.collections + " collections")
+      } else {
+        box.text(r.name + " failed from " + r.node + ": " + r.error)
+      }
+    })
+    .fail(targetFailed)
+}
+
+function removeTarget() {
+  $.ajax({url: targetURL(), type: "DELETE"})
+    .done(function() {
+      $("#targetstatus").removeClass('errorcount').text("Removed " + $("#targetname").val())
+    })
+    .fail(targetFailed)
 }
 
-// Send the command given plus the value of the input that has the
-// command's ID
+function changewc(sel) {
+  send("WRITECONCERN", "", sel.value)
+}
+
+// Send the command given with the number in the input that has the
+// command's ID, to the addressed nodes unless it's for the cluster
 function sendData(which) {
-  conn.send(which + " " + $("#" + which).val())
+  send(which, which == "CLUSTERQPS" ? "" : address(), Number($("#" + which).val()))
+}
+
+// Send bulk upsert settings
+function sendBulk() {
+  send("BULK", "", [$("#BULK").val(), $("#bulkordered").val(), $("#bulkrate").val()])
+}
+
+// Arm every node to start, and maybe stop, at the same instant.
+function schedule() {
+  $.post("schedule/", {in: $("#startin").val(), for: $("#runfor").val()})
+    .fail(function(xhr) {
+      $("#armed").text(xhr.responseText)
+    })
+}
+
+function disarm() {
+  $.ajax({url: "schedule/", type: "DELETE"})
+}
+
+// Show what the nodes are armed to do, from "startat <ns>",
+// "stopat <ns>" or "disarmed".
+var armed = {}
+function showArmed(value) {
+  var parts = value.split(" ")
+  if (parts[0] == "disarmed") {
+    armed = {}
+  } else {
+    armed[parts[0]] = new Date(Number(parts[1]) / 1e6)
+  }
+  var text = []
+  if (armed.startat) {
+    text.push("start at " + armed.startat.toLocaleTimeString())
+  }
+  if (armed.stopat) {
+    text.push("stop at " + armed.stopat.toLocaleTimeString())
+  }
+  $("#armed").text(text.length ? "Armed to " + text.join(", ") : "")
+}
+
+// Show which nodes confirmed the last few commands sent from this
+// node, from a DELIVERY: node name to its state, tries and error.
+var deliveries = []
+function showDelivery(d) {
+  var i = deliveries.findIndex(function(old) { return old.id == d.id })
+  if (i >= 0) {
+    deliveries[i] = d
+  } else {
+    deliveries = [d].concat(deliveries).slice(0, 5)
+  }
+  $("#deliveries").html(deliveries.map(function(d) {
+    var names = Object.keys(d.nodes).sort()
+    return $("<div>").text(d.type + (d.target ? " " + d.target : "") + ": " + names.map(function(name) {
+      var n = d.nodes[name]
+      switch (n.state) {
+        case 'done':
+          return name + " \u2713"
+        case 'refused':
+        case 'failed':
+          return name + " \u2717 (" + n.error + ")"
+      }
+      return name + " \u2026" + (n.tries > 1 ? " try " + n.tries : "")
+    }).join(", ")).html()
+  }).join("<br>"))
+}
+
+// Show how far off a node's scheduled start or stop was, from
+// "<start|stop> <microseconds>".
+var skews = {}
+function showSkew(id, value) {
+  var parts = value.split(" ")
+  if (!skews[id]) {
+    skews[id] = {}
+  }
+  skews[id][parts[0]] = (Number(parts[1]) / 1000).toFixed(1) + "ms"
+  var text = []
+  for (var what in skews[id]) {
+    text.push(what + " " + skews[id][what])
+  }
+  $("#skew-" + id).text("skew " + text.join(", "))
+}
+
+// Note what the database holds before a run, for verify().
+function baseline() {
+  $("#verified").text("Taking baseline...")
+  $.post("verify/baseline")
+    .done(function(data) {
+      $("#verified").text("Baseline taken " + new Date(data.time).toLocaleTimeString())
+    })
+    .fail(function(xhr) {
+      $("#verified").text(xhr.responseText)
+    })
+}
+
+// Check the database against the logs replayed. The VERIFIED message
+// shows the outcome.
+function verify() {
+  $("#verified").text("Verifying...")
+  $.post("verify/").fail(function(xhr) {
+    $("#verified").text(xhr.responseText)
+  })
+}
+
+function showVerified(msg) {
+  var box = $("#verified").empty().toggleClass('errorcount', !msg.ok)
+  box.append(msg.ok ? "All documents match" : "Mismatches")
+  if (!msg.baseline) {
+    box.append(" (no baseline)")
+  }
+  box.append(": ")
+  for (var coll in msg.value) {
+    var c = msg.value[coll]
+    var text = coll + " " + c.matched + "/" + c.checked
+    if (c.missing + c.low + c.high > 0) {
+      text += " (" + c.missing + " missing, " + c.low + " low, " + c.high + " high)"
+    }
+    box.append($("<a>").attr("href", "verify/" + coll + ".csv").text(text), " ")
+  }
+}
+
+// Post the chosen load profile to run from this node.
+function runProfile() {
+  var file = $("#profilefile")[0].files[0]
+  if (!file) {
+    return
+  }
+  var reader = new FileReader()
+  reader.onload = function() {
+    $.ajax({url: "profile/", type: "POST", data: reader.result, contentType: "application/json"})
+      .fail(function(xhr) {
+        $("#profileprogress").text(xhr.responseText)
+      })
+  }
+  reader.readAsText(file)
+}
+
+function stopProfile() {
+  $.ajax({url: "profile/", type: "DELETE"})
+}
+
+// Search from this node for the highest QPS the cluster can sustain.
+function runSearch() {
+  var config = {}
+  if ($("#searchp99").val()) {
+    config.MaxP99 = Number($("#searchp99").val())
+  }
+  if ($("#searcherrors").val()) {
+    config.MaxErrorRate = Number($("#searcherrors").val())
+  }
+  if ($("#searchstep").val()) {
+    config.Step = $("#searchstep").val()
+  }
+  $.ajax({url: "search/", type: "POST", data: JSON.stringify(config), contentType: "application/json"})
+    .fail(function(xhr) {
+      $("#searchprogress").text(xhr.responseText)
+    })
+}
+
+function stopSearch() {
+  $.ajax({url: "search/", type: "DELETE"})
+}
+
+// Show how a search is going, and its latency curve: each target
+// tried, what this node got and its p99.
+function showSearch(s) {
+  var best = s.best ? s.best.target + " QPS a node (" + Math.round(s.clusterqps) + " across " + s.nodes +
+      " nodes, p99 " + s.best.latency.p99.toFixed(1) + "ms)" : "nothing yet"
+  var text = "Search, step " + (s.steps.length + 1) + ": best " + best
+  if (s.done) {
+    text = (s.stopped ? "Search stopped: best " : "Search done: highest sustainable ") +
+        (s.best ? best : "none; even the first target missed")
+  }
+  $("#searchprogress").text(text)
+  $("#searchsteps").html(s.steps.map(function(step) {
+    return $("<span>").text(step.target + "\u2192" + Math.round(step.qps) + " p99 " + step.latency.p99.toFixed(1) + "ms " +
+        (step.ok ? "\u2713" : "\u2717")).attr('title', step.why || step.phase).prop('outerHTML')
+  }).join(", "))
+}
+
+// Show how far along a load profile is.
+function showProfile(p) {
+  var text = p.name + ": stage " + p.stage + "/" + p.stages + " " + p.kind +
+      ", " + Math.round(p.elapsed) + "s of " + Math.round(p.total) + "s, target " + p.target
+  if (p.done) {
+    text = p.name + ": done after " + Math.round(p.elapsed) + "s"
+  }
+  $("#profileprogress").text(text)
+}
+
+// Mark a profile stage change on the QPS chart.
+function markProfile(p, label) {
+  mainchart.xAxis[0].addPlotLine({
+    value: p.ts,
+    color: '#f0a30a',
+    width: 1,
+    label: {text: label, style: {color: '#f0a30a'}}
+  })
+}
+
+// Show the cluster-wide QPS target, or that each node has its own.
+function showClusterTarget(total) {
+  total = Number(total)
+  if (total > 0) {
+    $("#clustertarget").text("cluster target " + total + ", split across nodes")
+    $("#CLUSTERQPS").val(total)
+  } else {
+    $("#clustertarget").text("per-node targets")
+  }
+}
+
+// Send read workload settings
+function sendReads() {
+  send("READS", "", [$("#READS").val(), $("#readratio").val() || "0"])
+}
+
+// Send guardrails, as key=value settings
+function sendGuardrails() {
+  send("GUARDRAILS", "", $("#GUARDRAILS").val().split(/[\s,]+/).filter(function(arg) { return arg }))
+}
+
+// Say a guardrail stopped the run, and why.
+function showAborted(reason) {
+  $("#aborted").text("Aborted at " + new Date().toLocaleTimeString() + " by " + reason)
+}
+
+// Show a node's reads and the cluster's read total.
+var nodereads = {}
+function showReads(name, reads) {
+  nodereads[name] = reads
+  var id = nodes[name]
+  $("#reads-" + id).text("reads " + reads.qps + " of " + reads.target + ", " + reads.latency.p99.toFixed(1) + "ms p99")
+    .attr('title', reads.counts.failed + " failed, " + reads.skipped + " skipped with every reader busy")
+    .toggleClass('errorcount', reads.counts.failed > 0)
+  var total = 0
+  for (var node in nodereads) {
+    if (node in nodes) { // Not gone
+      total += nodereads[node].qps
+    }
+  }
+  $("#readtotal").text(total > 0 ? total + " reads/sec" : "")
 }
 
 // Add a new node panel.
@@ -85,8 +435,13 @@ function NewNode(data, id, reload) {
   )
   // Update the total count of nodes
   setNodes()
-  // Add the chart
+  // Show any errors
+  if (data.errors) {
+    showErrors(data.name, data.errors)
+  }
+  // Add the charts
   charts[id] = NewChart(data)
+  latcharts[id] = NewLatencyChart(data)
   // Add the logs
   if (data.logs) {
     var len = data.logs.length
@@ -111,6 +466,7 @@ function GoneNode(id) {
   delete nodes_by_id[id] // Don't change indices
   delete nodes[name]
   delete charts[id]
+  delete latcharts[id]
   setNodes()
 }
 
@@ -129,10 +485,16 @@ function AddNodes() {
     for (var i = 0; i < len; i++) {
       NewNode(data.nodes[i], i)
     }
+    showClusterTarget(data.clusterqps)
     // Set main chart data.
     var qpsdata = normData(data["qpsdata"])
     mainchart.series[0].setData(qpsdata)
     lastTS = qpsdata[qpsdata.length-1][0]
+    // And the cluster's latency
+    var latencydata = data["latencydata"] || []
+    for (var i = 0; i < latencydata.length; i++) {
+      mainlatchart.addPoint(latencydata[i])
+    }
     $('.grid').isotope( 'reloadItems' ).isotope()
   })
 }
@@ -188,6 +550,50 @@ function checkQPSData() {
 }
 
 
+// Show a node's error counts and update the cluster's totals.
+function showErrors(name, counts) {
+  nodeerrors[name] = counts
+  var id = nodes[name]
+  var total = 0
+  var detail = []
+  for (var cls in counts.errors) {
+    total += counts.errors[cls]
+    if (counts.errors[cls] > 0) {
+      detail.push(cls + " " + counts.errors[cls])
+    }
+  }
+  $("#errors-" + id).text("errors " + total)
+    .attr('title', detail.join(", ") + " (" + counts.retried + " retried, " + counts.failed + " failed)")
+    .toggleClass('errorcount', counts.failed > 0)
+
+  var sums = {attempted: 0, succeeded: 0, failed: 0, retried: 0, errors: {}}
+  for (var node in nodeerrors) {
+    if (!(node in nodes)) { // Gone
+      continue
+    }
+    var c = nodeerrors[node]
+    sums.attempted += c.attempted
+    sums.succeeded += c.succeeded
+    sums.failed += c.failed
+    sums.retried += c.retried
+    for (var cls in c.errors) {
+      sums.errors[cls] = (sums.errors[cls] || 0) + c.errors[cls]
+    }
+  }
+  var text = sums.attempted + " attempted, " + sums.succeeded + " succeeded, " +
+      sums.retried + " retried, " + sums.failed + " failed"
+  var classes = []
+  for (var cls in sums.errors) {
+    if (sums.errors[cls] > 0) {
+      classes.push(cls + " " + sums.errors[cls])
+    }
+  }
+  if (classes.length > 0) {
+    text += " — " + classes.join(", ")
+  }
+  $("#errortotals").text(text).toggleClass('errorcount', sums.failed > 0)
+}
+
 function nodeCount() {
   var count = 0
   var len = nodes_by_id.length
@@ -230,6 +636,7 @@ function SetupWebsocket () {
     nodes = {}
     nodes_by_id = []
     charts = {}
+    latcharts = {}
     // Add the nodes!
     if (len > 0) { // After Isotope finishes rendering
       var total = 0
@@ -258,7 +665,22 @@ function SetupWebsocket () {
       GoneNode(id)
       break
     case 'TARGETQPSAT':
-      $("#targetqps-" + id).text("target " + msg.value)
+      $("#targetqps-" + id).text("target " + msg.value) // Its share of any cluster target
+      break
+    case 'VERIFIED':
+      showVerified(msg)
+      break
+    case 'DELIVERY':
+      showDelivery(msg.value)
+      break
+    case 'ARMED':
+      showArmed(msg.value)
+      break
+    case 'SKEW':
+      showSkew(id, msg.value)
+      break
+    case 'CLUSTERQPSAT':
+      showClusterTarget(msg.value)
       break
     case 'PROCSAT':
       $("#procs-" + id).text("procs " + msg.value)
@@ -278,6 +700,38 @@ function SetupWebsocket () {
       QPSdata[msg.value[0]].sum += msg.value[1]
       QPSdata[msg.value[0]].count++
       break
+    case 'LATENCY':
+      if (msg.node == CLUSTERKEY) {
+        mainlatchart.addPoint(msg.value)
+        $('#latencytotal bold').text(msg.value[3].toFixed(1)) // p99
+      } else {
+        if (latcharts[id]) {
+          latcharts[id].addPoint(msg.value)
+        }
+        $("#p99-" + id).text(msg.value[3].toFixed(1))
+      }
+      break
+    case 'READSTATS':
+      showReads(msg.node, msg.value)
+      break
+    case 'READSSET':
+      var reads = msg.value.split(" ")
+      $("#READS").val(reads[0])
+      $("#readratio").val(reads[1])
+      break
+    case 'SEARCH':
+    case 'SEARCHDONE':
+      showSearch(msg.value)
+      break
+    case 'GUARDRAILSSET':
+      $("#GUARDRAILS").val(msg.value)
+      break
+    case 'ABORTED':
+      showAborted(msg.value)
+      break
+    case 'ERRORS':
+      showErrors(msg.node, msg.value)
+      break
     case 'STARTED':
       var button = $('#button_play-' + nodes[msg.node])
       button.toggleClass('btn-success', true)
@@ -299,8 +753,34 @@ function SetupWebsocket () {
     case 'LOG':
       insertLog(id, msg.value)
       break
+    case 'ERROR':
+      insertLog(id, "Refused " + msg.value.for + ": " + msg.value.error)
+      break
     case 'DBSWITCHED':
-      $("select").val(msg.db)
+      $("#whichdb").val(msg.db)
+      editTarget(msg.db)
+      break
+    case 'DBTARGETSSET':
+      loadTargets()
+      break
+    case 'BULKSET':
+      var bulk = msg.value.split(" ")
+      $("#BULK").val(bulk[0])
+      $("#bulkordered").val(bulk[1])
+      $("#bulkrate").val(bulk[2])
+      break
+    case 'PROFILE':
+      showProfile(msg.value)
+      break
+    case 'PROFILESTAGE':
+      markProfile(msg.value, msg.value.stage + " " + msg.value.kind)
+      break
+    case 'PROFILEDONE':
+      showProfile(msg.value)
+      markProfile(msg.value, "end")
+      break
+    case 'WRITECONCERNSET':
+      $("#writeconcern").val(msg.value)
       break
     }
     return true
@@ -329,7 +809,12 @@ $(document).ready(function() {
       id: "main",
       qpshistory: []
     }, 350)
+    mainlatchart = NewLatencyChart({
+      id: "main",
+      latencyhistory: []
+    }, 175)
     setTimeout(checkQPSData, 1000)
+    loadTargets()
   } else {
     alert("Your browser does not support WebSockets.")
   }
@@ -115,3 +115,95 @@ function NewChart(node, height) {
 
   return chart
 }
+
+var LATENCYSERIES = ['p50', 'p90', 'p99', 'p99.9', 'max']
+var LATENCYCOLORS = ['#b2c831', '#fff', '#fa1d2d', '#f0a30a', '#888']
+
+// Latency points are [timestamp, p50, p90, p99, p99.9, max] in ms.
+function NewLatencyChart(node, height) {
+  var chart
+
+  if (!height) {
+    height = 168
+  }
+
+  var history = node.latencyhistory || []
+  var series = []
+  for (var s = 0; s < LATENCYSERIES.length; s++) {
+    var data = []
+    for (var i = 0; i < history.length; i++) {
+      data.push([Number(history[i][0]), Number(history[i][s+1])])
+    }
+    series.push({
+      name: LATENCYSERIES[s],
+      color: LATENCYCOLORS[s],
+      type: 'line',
+      data: normData(data)
+    })
+  }
+
+  chart = new Highcharts.Chart({
+    chart: {
+      renderTo: 'latchart-' + node.id,
+      backgroundColor: 'transparent',
+      height: height,
+      marginLeft: 3,
+      marginRight: 3,
+      marginBottom: 0,
+      marginTop: 0,
+      zoomType: 'x'
+    },
+    title: {
+      text: ''
+    },
+    yAxis: {
+      gridLineWidth: 0,
+      min: 0
+    },
+    xAxis: {
+      type: 'datetime',
+    },
+    series: series,
+    credits: {
+      enabled: false
+    },
+    legend: {
+      enabled: false
+    },
+    plotOptions: {
+      line: {
+        lineWidth: 1,
+      },
+      series: {
+        marker: {
+          enabled: false,
+          radius: 1,
+        }
+      }
+    },
+    tooltip: {
+      shared: true,
+      backgroundColor: null,
+      borderWidth: 0,
+      shadow: false,
+      useHTML: true,
+      positioner: function () {
+        return { x: 150, y: -8 };
+      },
+      style: {
+        color: 'white',
+        padding: 0
+      },
+      headerFormat: '<span class="pull-right" style="font-size: 10px;">{point.key}</span>',
+      pointFormat: '<span class="pull-right" style="font-size: 12px;">&nbsp;{series.name} {point.y:.1f}ms</span>',
+    },
+  })
+
+  chart.addPoint = function(point) {
+    for (var s = 0; s < LATENCYSERIES.length; s++) {
+      chart.series[s].addPoint([point[0], point[s+1]], s == LATENCYSERIES.length - 1, true)
+    }
+  }
+
+  return chart
+}
@@ -0,0 +1,149 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/memberlist"
+	log "github.com/sirupsen/logrus"
+)
+
+// Labels are this node's labels, which commands can pick nodes by.
+// The other nodes learn them through memberlist's node metadata.
+// NewCluster takes them, as it does HostName.
+var Labels = map[string]string{}
+
+// Address picks which nodes a message is for. As text, in a Message's
+// Target and wherever users give one:
+//
+//	(empty), *             every node
+//	hitter-1               that node
+//	hitter-1,hitter-2      those nodes
+//	role=reader,az=1a      the nodes with all these labels
+type Address struct {
+	Nodes  []string
+	Labels map[string]string
+}
+
+func ParseAddress(s string) (Address, error) {
+	var a Address
+	s = strings.TrimSpace(s)
+	if s == "" || s == "*" {
+		return a, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if i := strings.Index(part, "="); i >= 0 {
+			key, value := strings.TrimSpace(part[:i]), strings.TrimSpace(part[i+1:])
+			if key == "" {
+				return a, fmt.Errorf("Bad label %q in address %q", part, s)
+			}
+			if a.Labels == nil {
+				a.Labels = map[string]string{}
+			}
+			a.Labels[key] = value
+		} else if part == "" || part == "*" {
+			return a, fmt.Errorf("Bad node name %q in address %q", part, s)
+		} else {
+			a.Nodes = append(a.Nodes, part)
+		}
+	}
+	if len(a.Nodes) > 0 && len(a.Labels) > 0 {
+		return a, fmt.Errorf("Address %q mixes node names and labels", s)
+	}
+	return a, nil
+}
+
+// ParseLabels reads labels given as key=value,key=value.
+func ParseLabels(s string) (map[string]string, error) {
+	a, err := ParseAddress(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(a.Nodes) > 0 {
+		return nil, fmt.Errorf("Labels %q need to be key=value", s)
+	}
+	if a.Labels == nil {
+		a.Labels = map[string]string{}
+	}
+	return a.Labels, nil
+}
+
+func (a Address) All() bool {
+	return len(a.Nodes) == 0 && len(a.Labels) == 0
+}
+
+// Matches says whether the node called name, with labels, is one a
+// picks.
+func (a Address) Matches(name string, labels map[string]string) bool {
+	if a.All() {
+		return true
+	}
+	for _, node := range a.Nodes {
+		if node == name {
+			return true
+		}
+	}
+	if len(a.Labels) == 0 {
+		return false
+	}
+	for key, value := range a.Labels {
+		if have, ok := labels[key]; !ok || have != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (a Address) String() string {
+	if a.All() {
+		return "*"
+	}
+	if len(a.Nodes) > 0 {
+		return strings.Join(a.Nodes, ",")
+	}
+	var parts []string
+	for key, value := range a.Labels {
+		parts = append(parts, key+"="+value)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// nodeLabels are the labels a member advertised.
+func nodeLabels(n *memberlist.Node) map[string]string {
+	labels := map[string]string{}
+	if len(n.Meta) > 0 {
+		if err := json.Unmarshal(n.Meta, &labels); err != nil {
+			log.Warnf("Bad labels from %s: %s", n.Name, err)
+		}
+	}
+	return labels
+}
+
+// Addressed gives the members target picks, and any nodes it names
+// that aren't members.
+func (c *Cluster) Addressed(target string) (nodes []*memberlist.Node, missing []string, err error) {
+	a, err := ParseAddress(target)
+	if err != nil {
+		return nil, nil, err
+	}
+	found := map[string]bool{}
+	for _, member := range c.Members.Members() {
+		if a.Matches(member.Name, c.labels(member)) {
+			nodes = append(nodes, member)
+			found[member.Name] = true
+		}
+	}
+	for _, name := range a.Nodes {
+		if !found[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(nodes) == 0 && len(missing) == 0 {
+		return nil, nil, fmt.Errorf("No nodes match %s", a)
+	}
+	return nodes, missing, nil
+}
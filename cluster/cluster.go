@@ -7,7 +7,6 @@ import (
 	"io/ioutil"
 	"net/http"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +20,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/memberlist"
 	. "github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/stats"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -30,45 +30,85 @@ var (
 	ClusterAuthProfile = "lyfe"
 	Clus               *Cluster
 	PROCS              = 1
+
+	// CLUSTERQPS is the target for the whole cluster, which the
+	// master splits among the live members. Zero means every node
+	// runs at PERSEC on its own.
+	CLUSTERQPS = 0
+
+	// How long to let gossip settle after a join or leave before
+	// splitting CLUSTERQPS again.
+	RebalanceDelay = time.Second
 )
 
 type NodeConfig struct {
-	Logs   CircBufMap
-	Qps    CircBufMap
-	States map[string]string
-	Active map[string]map[string]bool
+	Logs    CircBufMap
+	Qps     CircBufMap
+	Latency CircBufMap
+	Errors  map[string]stats.Counts
+	States  map[string]string
+	Active  map[string]map[string]bool
+	Targets map[string]int // Each node's TARGETQPS
+	Procs   map[string]int
+
+	ClusterQPS int
+	DBTargets  TargetSet
 }
 
 type Delegate struct {
 	name       string
-	enginemsgs *chan []byte
-	uimsgs     *chan []byte
+	enginemsgs *chan Message
+	uimsgs     *chan Message
 	State      string
 
 	cluster *Cluster
 }
 
-const (
-	ENGINEMESSAGE = iota
-	UIMESSAGE
-)
-
-func (m *Delegate) NodeMeta(limit int) []byte                  { return nil }
 func (m *Delegate) GetBroadcasts(overhead, limit int) [][]byte { return nil }
 
+// NodeMeta gives our Labels to the other nodes.
+func (m *Delegate) NodeMeta(limit int) []byte {
+	b, _ := json.Marshal(m.cluster.Labels)
+	if len(b) > limit {
+		log.Warnf("Labels take %d bytes, more than the %d allowed; not sharing them", len(b), limit)
+		return nil
+	}
+	return b
+}
+
 func (m *Delegate) LocalState(join bool) []byte {
 	m.cluster.ConfigMutex.RLock()
 	defer m.cluster.ConfigMutex.RUnlock()
 	config := NodeConfig{
-		Qps:    m.cluster.Qps,
-		Logs:   m.cluster.Logs,
-		States: m.cluster.States,
-		Active: m.cluster.Active,
+		Qps:     m.cluster.Qps,
+		Logs:    m.cluster.Logs,
+		Latency: m.cluster.Latency,
+		Errors:  m.cluster.Errors,
+		States:  m.cluster.States,
+		Active:  m.cluster.Active,
+		Targets: withOwn(m.cluster.Targets, m.cluster.Name, PERSEC),
+		Procs:   withOwn(m.cluster.Procs, m.cluster.Name, PROCS),
+
+		ClusterQPS: CLUSTERQPS,
+	}
+	if m.cluster.Encrypted() { // Targets carry database passwords
+		config.DBTargets = Targets()
 	}
 	b, _ := json.Marshal(config)
 	return b
 }
 
+// withOwn copies what's known of each node's setting, with ours as it
+// is now.
+func withOwn(known map[string]int, name string, own int) map[string]int {
+	all := make(map[string]int, len(known)+1)
+	for node, n := range known {
+		all[node] = n
+	}
+	all[name] = own
+	return all
+}
+
 func (m *Delegate) MergeRemoteState(s []byte, join bool) {
 	mems := make(map[string]bool)
 	// Only merge in nodes that are in the member list
@@ -87,24 +127,70 @@ func (m *Delegate) MergeRemoteState(s []byte, join bool) {
 	}
 	m.cluster.ConfigMutex.Lock()
 	defer m.cluster.ConfigMutex.Unlock()
+	if join && CLUSTERQPS == 0 { // Pick up a target set before we got here.
+		CLUSTERQPS = nodes.ClusterQPS
+	}
+	if nodes.DBTargets.Version > Targets().Version { // Changed while we weren't looking
+		if msg, err := NewMessage(m.cluster.Name, m.cluster.Name, "DBTARGETS", nodes.DBTargets); err == nil {
+			go m.deliver(*msg)
+		}
+	}
 	for host, _ := range nodes.Qps {
 		if _, ok := mems[host]; ok {
 			m.cluster.Qps[host] = nodes.Qps[host]
 			m.cluster.Logs[host] = nodes.Logs[host]
+			m.cluster.Latency[host] = nodes.Latency[host]
+			m.cluster.Errors[host] = nodes.Errors[host]
 			m.cluster.States[host] = nodes.States[host]
 			m.cluster.Active[host] = nodes.Active[host]
+			if target, ok := nodes.Targets[host]; ok {
+				m.cluster.Targets[host] = target
+			}
+			if procs, ok := nodes.Procs[host]; ok {
+				m.cluster.Procs[host] = procs
+			}
+		}
+	}
+}
+
+func (m *Delegate) NotifyMsg(b []byte) {
+	var msg Message
+	if err := json.Unmarshal(b, &msg); err != nil {
+		log.Warnf("Undecodable message: %s", err)
+		return
+	}
+	if err := msg.Validate(); err != nil {
+		log.Warnf("Refused %s from %s: %s", msg.Type, msg.Sender, err)
+		if msg.Type != "ERROR" && msg.Sender != "" {
+			m.cluster.Reply(&msg, "ERROR", ErrorReply{Error: err.Error(), For: msg.Type})
+		}
+		return
+	}
+	switch {
+	case msg.Type == "ACK":
+		m.cluster.acked(msg.ID, msg.Sender)
+	case msg.Type == "HANDLED":
+		m.cluster.handled(msg.ID, msg.Sender, "")
+	case msg.Type == "ERROR":
+		var reply ErrorReply
+		if msg.Decode(&reply) == nil {
+			m.cluster.handled(msg.ID, msg.Sender, reply.Error)
 		}
+		m.deliver(msg)
+	case msg.isControl():
+		if msg.For(m.name, m.cluster.Labels) {
+			m.cluster.receive(msg)
+		}
+	default:
+		m.deliver(msg)
 	}
 }
 
-func (m *Delegate) NotifyMsg(msg []byte) {
-	cp := make([]byte, len(msg)-1)
-	copy(cp, msg[1:])
-	switch msg[0] {
-	case ENGINEMESSAGE:
-		*m.enginemsgs <- cp
-	case UIMESSAGE:
-		*m.uimsgs <- cp
+func (m *Delegate) deliver(msg Message) {
+	if msg.isEngine() {
+		*m.enginemsgs <- msg
+	} else {
+		*m.uimsgs <- msg
 	}
 }
 
@@ -119,6 +205,7 @@ func (e *Eventer) NotifyJoin(n *memberlist.Node) {
 		"node": e.cluster.NewNode(n),
 	}
 	WS.WriteJSON(message)
+	time.AfterFunc(RebalanceDelay, e.cluster.Rebalance)
 }
 
 func (e *Eventer) NotifyLeave(n *memberlist.Node) {
@@ -126,13 +213,18 @@ func (e *Eventer) NotifyLeave(n *memberlist.Node) {
 	defer e.cluster.ConfigMutex.Unlock()
 	delete(e.cluster.Qps, n.Name)
 	delete(e.cluster.Logs, n.Name)
+	delete(e.cluster.Latency, n.Name)
+	delete(e.cluster.Errors, n.Name)
 	delete(e.cluster.States, n.Name)
+	delete(e.cluster.Targets, n.Name)
+	delete(e.cluster.Procs, n.Name)
 	// Inform UI of a dead member
 	message := map[string]interface{}{
 		"type": "GONENODE",
 		"node": n.Name,
 	}
 	WS.WriteJSON(message)
+	time.AfterFunc(RebalanceDelay, e.cluster.Rebalance)
 }
 
 func (e *Eventer) NotifyUpdate(n *memberlist.Node) {}
@@ -140,16 +232,27 @@ func (e *Eventer) NotifyUpdate(n *memberlist.Node) {}
 type Cluster struct {
 	Port        int
 	Name        string
+	Labels      map[string]string
 	StartTime   time.Time
 	Members     *memberlist.Memberlist
 	Delegate    *Delegate
-	EngineMsgs  chan []byte
-	UIMsgs      chan []byte
+	EngineMsgs  chan Message
+	UIMsgs      chan Message
 	Logs        CircBufMap
 	Qps         CircBufMap
+	Latency     CircBufMap              // Percentiles per second, plus the whole cluster's under ClusterKey
+	Errors      map[string]stats.Counts // Latest running totals from each node
 	States      map[string]string
 	ConfigMutex sync.RWMutex
 	Active      map[string]map[string]bool
+	Targets     map[string]int // Each node's TARGETQPS, as it last said
+	Procs       map[string]int // And its PROCS
+
+	deliveries    []*Delivery // Control messages we sent lately, oldest first
+	deliveryMutex sync.Mutex
+	seen          map[string]time.Time // IDs of control messages we've acted on
+	seenMutex     sync.Mutex
+	keyring       *memberlist.Keyring // Nil when gossip is in the clear
 }
 
 var ct int
@@ -159,15 +262,22 @@ func NewCluster() *Cluster {
 	c.Port = ClusterPort // The port I listen on.
 	var err error
 	c.Name = HostName
+	c.Labels = Labels
 	if err != nil {
 		panic(err)
 	}
 	// State data
 	c.Qps = NewCircBufMap()
 	c.Logs = NewCircBufMap()
+	c.Latency = NewCircBufMap()
+	c.Errors = map[string]stats.Counts{}
+	c.seen = map[string]time.Time{}
+	c.Targets = map[string]int{}
+	c.Procs = map[string]int{}
 	c.States = map[string]string{HostName: "stop"} // Whether each node is started, stopped, or what.
 	c.Qps.MakeNode(HostName)                       // Register ourselves
 	c.Logs.MakeNode(HostName)                      // Register ourselves
+	c.Latency.MakeNode(HostName)                   // Register ourselves
 
 	c.Active = map[string]map[string]bool{
 		c.Name: map[string]bool{
@@ -180,8 +290,8 @@ func NewCluster() *Cluster {
 	}
 
 	// Message queues
-	c.EngineMsgs = make(chan []byte, 100)
-	c.UIMsgs = make(chan []byte, 100)
+	c.EngineMsgs = make(chan Message, 100)
+	c.UIMsgs = make(chan Message, 100)
 	c.Delegate = &Delegate{
 		enginemsgs: &c.EngineMsgs,
 		uimsgs:     &c.UIMsgs,
@@ -195,25 +305,47 @@ func NewCluster() *Cluster {
 
 // Contact other hitters and create a SWIM cluster
 func (c *Cluster) Start() (err error) {
-	creds := credentials.NewSharedCredentials("", ClusterAuthProfile)
-	svc := ec2metadata.New(session.New(&aws.Config{
-		Credentials: creds,
-		Region:      aws.String(ClusterRegion),
-	}))
-	var ipAddr string
-	ipAddr, err = svc.GetMetadata("/local-ipv4")
-	if err != nil {
-		return
+	ipAddr := BindAddr
+	if ipAddr == "" {
+		ipAddr = "0.0.0.0"
+		if local, ok := Discover.(localAddresser); ok {
+			if ipAddr, err = local.LocalAddr(); err != nil {
+				return
+			}
+		}
 	}
 
 	ml := memberlist.DefaultLANConfig()
 	ml.Name = c.Name
 	ml.BindPort = c.Port
 	ml.BindAddr = ipAddr
+	ml.AdvertiseAddr = AdvertiseAddr
+	if ml.AdvertiseAddr == "" && ipAddr == "0.0.0.0" {
+		if ml.AdvertiseAddr, err = InterfaceAddr(); err != nil {
+			return
+		}
+	}
+	if ml.AdvertiseAddr != "" {
+		ml.AdvertisePort = c.Port
+	}
 	ml.Delegate = c.Delegate
 	ml.PushPullInterval = time.Second * 5
 	ml.LogOutput = ioutil.Discard
 
+	keys, err := LoadKeys()
+	if err != nil {
+		return
+	}
+	if len(keys) > 0 {
+		if c.keyring, err = memberlist.NewKeyring(keys, keys[0]); err != nil {
+			return
+		}
+		ml.Keyring = c.keyring
+	} else {
+		log.Warnf("No gossip keys: anyone who can reach port %d can join the cluster, "+
+			"and DB target passwords would go to other nodes in the clear, so changes to them aren't gossiped", c.Port)
+	}
+
 	for retries := 50; retries > 0; retries-- {
 		c.Members, err = memberlist.Create(ml) // Starts listener
 		if err == nil {
@@ -231,7 +363,7 @@ func (c *Cluster) Start() (err error) {
 
 	ml.Events = &Eventer{cluster: c}
 
-	others, err := FindEC2Hitters()
+	others, err := Discover.Peers()
 	if err != nil {
 		return
 	}
@@ -248,6 +380,18 @@ func (c *Cluster) Count() int {
 	return c.Members.NumMembers()
 }
 
+// Settle waits until no node has joined or left for quiet, so that
+// what's sent to every node reaches the ones starting alongside us.
+func (c *Cluster) Settle(quiet time.Duration) {
+	count, since := c.Count(), time.Now()
+	for time.Since(since) < quiet {
+		time.Sleep(quiet / 10)
+		if n := c.Count(); n != count {
+			count, since = n, time.Now()
+		}
+	}
+}
+
 func (c *Cluster) AmMaster() bool {
 	mems := []string{}
 	for _, member := range c.Members.Members() {
@@ -260,46 +404,74 @@ func (c *Cluster) AmMaster() bool {
 	return false
 }
 
-// Note: sends to everyone, including myself!
+// Note: may send to myself!
 // May lead to circular messages if not careful.
-func (c *Cluster) send(which int, msg string) {
-	codedmsg := append([]byte{byte(which)}, []byte(msg)...)
-	for _, member := range c.Members.Members() {
-		if member.Name == c.Name { // Not me, the NotifyMsg() below will do that.
+// Send sends msg to the members its Target addresses, us included if
+// it does. Control messages are retried until acked (see Deliveries).
+func (c *Cluster) Send(msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	nodes, missing, err := c.Addressed(msg.Target)
+	if err != nil {
+		return err
+	}
+	if msg.isControl() {
+		c.sendReliable(msg, b, nodes, missing)
+		return nil
+	}
+	self := false
+	for _, node := range nodes {
+		if node.Name == c.Name { // Not me, the deliver() below will do that.
+			self = true
 			continue
 		}
-		c.Members.SendToUDP(member, codedmsg)
+		c.Members.SendToUDP(node, b)
+	}
+	if self {
+		c.Delegate.deliver(*msg) // Send to ourselves!
+	}
+	return nil
+}
+
+func (c *Cluster) sendNew(target, typ string, payload ...interface{}) error {
+	msg, err := NewMessage(c.Name, target, typ, payload...)
+	if err != nil {
+		log.Warnf("Not sending %s: %s", typ, err)
+		return err
 	}
-	c.Delegate.NotifyMsg(codedmsg) // Send to ourselves!
+	return c.Send(msg)
 }
 
-func (c *Cluster) SendEngine(msg string) {
-	c.send(ENGINEMESSAGE, msg)
+// SendEngine tells every node's engine to do typ. For commands that
+// need a target, that's "*".
+func (c *Cluster) SendEngine(typ string, payload ...interface{}) error {
+	return c.sendNew("", typ, payload...)
 }
 
-func (c *Cluster) SendUI(cmd string, msg ...interface{}) {
-	var data string
-	if len(msg) > 0 {
-		switch msg := msg[0].(type) {
-		case uint64:
-			data = strconv.FormatUint(msg, 10)
-		case int:
-			data = strconv.Itoa(msg)
-		case string:
-			data = msg
-		case []uint64:
-			var datums []string
-			for _, datum := range msg {
-				datums = append(datums, strconv.FormatUint(datum, 10))
-			}
-			data = strings.Join(datums, " ")
-		default:
-			panic(fmt.Sprintf("No clue what this is: %#v (cmd %s)", msg, cmd))
-		}
-		c.send(UIMESSAGE, fmt.Sprintf("%s %s %s", cmd, c.Name, data))
-	} else {
-		c.send(UIMESSAGE, fmt.Sprintf("%s %s", cmd, c.Name))
+// SendEngineTo tells the engines of the nodes at address to do typ.
+func (c *Cluster) SendEngineTo(address, typ string, payload ...interface{}) error {
+	return c.sendNew(address, typ, payload...)
+}
+
+// SendUI tells every node's UI about something on this node.
+func (c *Cluster) SendUI(typ string, payload ...interface{}) error {
+	return c.sendNew("", typ, payload...)
+}
+
+// Reply answers msg, to whoever sent it.
+func (c *Cluster) Reply(msg *Message, typ string, payload ...interface{}) error {
+	reply, err := NewMessage(c.Name, msg.Sender, typ, payload...)
+	if err != nil {
+		log.Warnf("Not replying %s: %s", typ, err)
+		return err
 	}
+	reply.ID = msg.ID
+	return c.Send(reply)
 }
 
 func (c *Cluster) Join(host string) error {
@@ -315,6 +487,41 @@ func (c *Cluster) Stop() {
 	c.Members.Shutdown()
 }
 
+// SplitQPS divides total among members as evenly as it goes, the
+// first few alphabetically taking any remainder.
+func SplitQPS(total int, members []string) map[string]int {
+	sorted := append([]string{}, members...)
+	sort.Strings(sorted)
+	shares := make(map[string]int, len(sorted))
+	for i, name := range sorted {
+		shares[name] = total / len(sorted)
+		if i < total%len(sorted) {
+			shares[name]++
+		}
+	}
+	return shares
+}
+
+// Rebalance has the master tell each live member its share of
+// CLUSTERQPS with a "NODEQPS <host> <share> <total>" message.
+func (c *Cluster) Rebalance() {
+	total := CLUSTERQPS
+	if total == 0 || !c.AmMaster() {
+		return
+	}
+	mems := []string{}
+	for _, member := range c.Members.Members() {
+		mems = append(mems, member.Name)
+	}
+	if total < len(mems) { // Nodes can't go below 1 QPS
+		Log("Cluster target of %d QPS is less than one a node; the %d nodes will run %d QPS between them",
+			total, len(mems), len(mems))
+	}
+	for name, share := range SplitQPS(total, mems) {
+		c.SendEngineTo(name, "NODEQPS", NodeQPS{Share: share, Total: total})
+	}
+}
+
 // Report to someone hitting /cluster-status
 func (c *Cluster) Status(w http.ResponseWriter, r *http.Request) {
 	mems := []string{}
@@ -349,6 +556,10 @@ func (c *Cluster) NewNode(member *memberlist.Node) map[string]interface{} {
 	if c.States[member.Name] == "" {
 		c.States[member.Name] = "stop"
 	}
+	targetqps, procs := c.Targets[member.Name], c.Procs[member.Name]
+	if member.Name == c.Name {
+		targetqps, procs = PERSEC, PROCS
+	}
 	colls := []map[string]string{}
 	for coll, state := range c.Active[member.Name] {
 		collmap := map[string]string{
@@ -366,30 +577,42 @@ func (c *Cluster) NewNode(member *memberlist.Node) map[string]interface{} {
 	})
 
 	return map[string]interface{}{
-		"name":       member.Name,
-		"qpshistory": c.Qps[member.Name],
-		"logs":       c.Logs[member.Name],
-		"targetqps":  PERSEC,
-		"procs":      PROCS,
-		"state":      c.States[member.Name],
-		"colls":      colls,
+		"name":           member.Name,
+		"qpshistory":     c.Qps[member.Name],
+		"latencyhistory": c.Latency[member.Name],
+		"errors":         c.Errors[member.Name],
+		"logs":           c.Logs[member.Name],
+		"targetqps":      targetqps,
+		"procs":          procs,
+		"state":          c.States[member.Name],
+		"colls":          colls,
+		"labels":         c.labels(member),
 	}
 }
 
-var HostName = GetAWSHostname()
+func (c *Cluster) labels(member *memberlist.Node) map[string]string {
+	if member.Name == c.Name {
+		return c.Labels
+	}
+	return nodeLabels(member)
+}
 
-// Get my AWS hostname
-func GetAWSHostname() string {
+// HostName is this node's name in the cluster. main sets it from
+// -hostname, or from EC2 when discovering through it.
+var HostName = OSHostName()
+
+func ec2Metadata(path string) (string, error) {
 	creds := credentials.NewSharedCredentials("", ClusterAuthProfile)
 	svc := ec2metadata.New(session.New(&aws.Config{
 		Credentials: creds,
 		Region:      aws.String(ClusterRegion),
 	}))
-	host, err := svc.GetMetadata("/public-hostname")
-	if err != nil {
-		panic(err)
-	}
-	return host
+	return svc.GetMetadata(path)
+}
+
+// Get my AWS hostname
+func GetAWSHostname() (string, error) {
+	return ec2Metadata("/public-hostname")
 }
 
 func FindEC2Hitters() ([]string, error) {
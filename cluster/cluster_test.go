@@ -2,10 +2,14 @@ package cluster_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"reflect"
 	"testing"
 	"time"
@@ -15,6 +19,7 @@ import (
 	"github.com/bouk/monkey"
 	"github.com/hashicorp/memberlist"
 	. "github.com/lyfe-mobile/hitter/cluster"
+	_ "github.com/lyfe-mobile/hitter/engine" // For the engine's messages
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
@@ -29,6 +34,91 @@ func BeBetween(min, max int) types.GomegaMatcher {
 var hostCount int
 
 var _ = Describe("Clustering", func() {
+	RegisterMessage("SOMEMESSAGE", MessageType{Engine: true, Payload: func() interface{} { return new(string) }})
+	RegisterMessage("SOMECOMMAND", MessageType{Engine: true, Control: true, Payload: func() interface{} { return new(string) }})
+
+	It("splits the cluster QPS target", func() {
+		Ω(SplitQPS(1000, []string{"c", "a", "b"})).Should(Equal(map[string]int{"a": 334, "b": 333, "c": 333}))
+		Ω(SplitQPS(900, []string{"a", "b", "c"})).Should(Equal(map[string]int{"a": 300, "b": 300, "c": 300}))
+		Ω(SplitQPS(1, []string{"a", "b"})).Should(Equal(map[string]int{"a": 1, "b": 0}))
+	})
+	It("finds seeds", func() {
+		Ω(ParseDiscovery("ec2")).Should(Equal(EC2Discovery{}))
+		Ω(ParseDiscovery("dns:hitter.lab")).Should(Equal(DNSDiscovery{Name: "hitter.lab"}))
+		Ω(ParseDiscovery("srv:_hitter._udp.lab")).Should(Equal(DNSDiscovery{Name: "_hitter._udp.lab", SRV: true}))
+		_, err := ParseDiscovery("file:")
+		Ω(err).Should(HaveOccurred())
+		_, err = ParseDiscovery("consul:hitter")
+		Ω(err).Should(HaveOccurred())
+
+		d, err := ParseDiscovery("static:10.0.0.5, 10.0.0.6:7946")
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(d.Peers()).Should(Equal([]string{fmt.Sprintf("10.0.0.5:%d", ClusterPort), "10.0.0.6:7946"}))
+		d, _ = ParseDiscovery("none")
+		Ω(d.Peers()).Should(BeEmpty())
+
+		f, err := ioutil.TempFile("", "seeds")
+		Ω(err).ShouldNot(HaveOccurred())
+		defer os.Remove(f.Name())
+		f.WriteString("# Lab boxes\nlab1\n\n  lab2:9000\n")
+		f.Close()
+		d, _ = ParseDiscovery("file:" + f.Name())
+		Ω(d.Peers()).Should(Equal([]string{fmt.Sprintf("lab1:%d", ClusterPort), "lab2:9000"}))
+
+		By("picking an address to advertise")
+		addr, err := InterfaceAddr()
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(net.ParseIP(addr).To4()).ShouldNot(BeNil())
+	})
+	It("parses addresses", func() {
+		Ω(ParseAddress("")).Should(Equal(Address{}))
+		Ω(ParseAddress("*")).Should(Equal(Address{}))
+		Ω(ParseAddress("c1, c2")).Should(Equal(Address{Nodes: []string{"c1", "c2"}}))
+		Ω(ParseAddress("role=reader,az=1a")).Should(Equal(Address{Labels: map[string]string{"role": "reader", "az": "1a"}}))
+		for _, bad := range []string{"c1,role=reader", "c1,,c2", "=reader"} {
+			_, err := ParseAddress(bad)
+			Ω(err).Should(HaveOccurred(), bad)
+		}
+
+		a, _ := ParseAddress("role=reader")
+		Ω(a.Matches("c1", map[string]string{"role": "reader", "az": "1a"})).Should(BeTrue())
+		Ω(a.Matches("c2", map[string]string{"role": "writer"})).Should(BeFalse())
+		Ω(a.Matches("c3", nil)).Should(BeFalse())
+		a, _ = ParseAddress("c1,c2")
+		Ω(a.Matches("c2", nil)).Should(BeTrue())
+		Ω(a.Matches("c3", nil)).Should(BeFalse())
+		Ω(Address{}.Matches("c3", nil)).Should(BeTrue())
+		Ω(Address{Labels: map[string]string{"b": "2", "a": "1"}}.String()).Should(Equal("a=1,b=2"))
+
+		_, err := ParseLabels("role")
+		Ω(err).Should(HaveOccurred())
+		Ω(ParseLabels("")).Should(BeEmpty())
+	})
+	It("reads gossip keys", func() {
+		_, err := ParseKey("c2hvcnQ=")
+		Ω(err).Should(MatchError("Gossip keys need to be 16, 24 or 32 bytes, not 5"))
+		key, _ := GenerateKey()
+		Ω(ParseKey(key)).Should(HaveLen(32))
+
+		f, err := ioutil.TempFile("", "keys")
+		Ω(err).ShouldNot(HaveOccurred())
+		defer os.Remove(f.Name())
+		f.WriteString("# In use\n" + key + "\n\nAAAAAAAAAAAAAAAAAAAAAA==\n")
+		f.Close()
+		KeysFile = f.Name()
+		defer func() { KeysFile = "" }()
+		Ω(LoadKeys()).Should(HaveLen(2))
+	})
+	It("checks roles", func() {
+		Ω(ParseRole("operator")).Should(Equal(Operator))
+		_, err := ParseRole("none")
+		Ω(err).Should(HaveOccurred())
+		Ω(Allowed(Operator, "SOMECOMMAND")).Should(Succeed())
+		Ω(Allowed(Viewer, "SOMECOMMAND")).Should(MatchError("SOMECOMMAND needs the operator role"))
+		Ω(Allowed(Operator, "SOMEMESSAGE")).Should(MatchError("SOMEMESSAGE needs the admin role"))
+		Ω(Allowed(Admin, "SOMEMESSAGE")).Should(Succeed())
+		Ω(Allowed(Admin, "NOSUCHTHING")).ShouldNot(Succeed())
+	})
 	Describe("StartCluster", func() {
 		var (
 			cluster *Cluster
@@ -93,9 +183,102 @@ var _ = Describe("Clustering", func() {
 			Ω(c1.Start()).Should(Succeed())
 			c1.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(cluster)))
 			MemberCountShouldBe(c1, 2)
-			msg := "SomeMessage"
-			cluster.SendEngine(msg)
-			Eventually(c1.EngineMsgs).Should(Receive(Equal([]byte(msg))))
+			Ω(cluster.SendEngine("SOMEMESSAGE", "hi")).Should(Succeed())
+			Eventually(c1.EngineMsgs).Should(Receive(Says(`^SOMEMESSAGE primary "hi"$`)))
+
+			By("refusing what it can't validate")
+			Ω(cluster.SendEngine("SOMEMESSAGE")).ShouldNot(Succeed())
+			b, _ := json.Marshal(Message{Version: ProtocolVersion, Type: "NODEQPS", Sender: cluster.Name, ID: "x1"})
+			c1.Delegate.NotifyMsg(b)
+			var reply Message
+			Eventually(cluster.UIMsgs).Should(Receive(&reply))
+			Ω(reply.Type).Should(Equal("ERROR"))
+			Ω(reply.ID).Should(Equal("x1"))
+			Ω(reply.String()).Should(ContainSubstring("NODEQPS needs a target node"))
+			Consistently(c1.EngineMsgs).ShouldNot(Receive())
+		})
+		It("confirms control messages", func() {
+			HostName = "c1"
+			c1 := NewCluster()
+			c1.Port = 0
+			defer c1.Stop()
+			Ω(c1.Start()).Should(Succeed())
+			c1.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(cluster)))
+			MemberCountShouldBe(c1, 2)
+
+			Ω(cluster.SendEngine("SOMECOMMAND", "hi")).Should(Succeed())
+			Eventually(cluster.EngineMsgs).Should(Receive(Says(`^SOMECOMMAND primary "hi"$`)))
+			Eventually(c1.EngineMsgs).Should(Receive(Says(`^SOMECOMMAND primary "hi"$`)))
+			Eventually(func() []Delivery { return cluster.Deliveries() }).Should(ConsistOf(
+				WithTransform(func(d Delivery) bool { return d.Settled() }, BeTrue())))
+			d := cluster.Deliveries()[0]
+			Ω(d.Type).Should(Equal("SOMECOMMAND"))
+			Ω(d.Nodes).Should(HaveLen(2))
+			Ω(d.Nodes["primary"].State).Should(Equal("acked"))
+			Ω(d.Nodes["c1"].State).Should(Equal("acked"))
+			Ω(d.Nodes["c1"].Tries).Should(Equal(1))
+			Ω(cluster.Delivery(d.ID)).Should(Equal(d))
+
+			By("acting on a repeat only once")
+			b, _ := json.Marshal(Message{Version: ProtocolVersion, Type: "SOMECOMMAND", Sender: cluster.Name, ID: "x2", Payload: []byte(`"again"`)})
+			c1.Delegate.NotifyMsg(b)
+			c1.Delegate.NotifyMsg(b)
+			Eventually(c1.EngineMsgs).Should(Receive(Says(`"again"$`)))
+			Consistently(c1.EngineMsgs).ShouldNot(Receive())
+
+			By("confirming engine commands")
+			var mine, theirs Message
+			Ω(cluster.SendEngineTo("*", "START")).Should(Succeed())
+			Eventually(cluster.EngineMsgs).Should(Receive(&mine))
+			Eventually(c1.EngineMsgs).Should(Receive(&theirs))
+			Ω(mine).Should(Says(`^START primary`))
+			Ω(theirs).Should(Says(`^START primary`))
+			Eventually(func() bool { return cluster.Deliveries()[0].Settled() }).Should(BeTrue())
+			d = cluster.Deliveries()[0]
+			Ω(d.Type).Should(Equal("START"))
+			Ω(d.Nodes["primary"].State).Should(Equal("acked"))
+			Ω(d.Nodes["c1"].State).Should(Equal("acked"))
+			Ω(d.Handled()).Should(BeFalse())
+
+			By("hearing how each node took them")
+			cluster.Handled(&mine, nil)
+			c1.Handled(&theirs, errors.New("Not now"))
+			Eventually(func() bool { return cluster.Deliveries()[0].Handled() }).Should(BeTrue())
+			d = cluster.Deliveries()[0]
+			Ω(d.Nodes["primary"].State).Should(Equal("done"))
+			Ω(d.Nodes["c1"].State).Should(Equal("refused"))
+			Ω(d.Nodes["c1"].Error).Should(Equal("Not now"))
+			Eventually(cluster.UIMsgs).Should(Receive(Says(`^ERROR c1 .*Not now`)))
+
+			By("owning up to a node that isn't there")
+			Ω(cluster.SendEngineTo("ghost", "SOMECOMMAND", "boo")).Should(Succeed())
+			d = cluster.Deliveries()[0]
+			Ω(d.Settled()).Should(BeTrue())
+			Ω(d.Nodes).Should(Equal(map[string]NodeDelivery{"ghost": {State: "failed", Error: "Not in the cluster"}}))
+		})
+		It("reports each node's own target", func() {
+			HostName = "c1"
+			c1 := NewCluster()
+			c1.Port = 0
+			defer c1.Stop()
+			Ω(c1.Start()).Should(Succeed())
+			c1.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(cluster)))
+			MemberCountShouldBe(c1, 2)
+			Ω(cluster.Targets).Should(HaveKeyWithValue("c1", PERSEC)) // Gossiped on joining
+
+			cluster.ConfigMutex.Lock()
+			cluster.Targets["c1"], cluster.Procs["c1"] = PERSEC+250, 7
+			cluster.ConfigMutex.Unlock()
+			for _, member := range cluster.Members.Members() {
+				node := cluster.NewNode(member)
+				if member.Name == "c1" {
+					Ω(node["targetqps"]).Should(Equal(PERSEC + 250))
+					Ω(node["procs"]).Should(Equal(7))
+				} else {
+					Ω(node["targetqps"]).Should(Equal(PERSEC))
+					Ω(node["procs"]).Should(Equal(PROCS))
+				}
+			}
 		})
 		It("communicates with two", func() {
 			HostName = "c1"
@@ -113,10 +296,84 @@ var _ = Describe("Clustering", func() {
 			c2.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(cluster)))
 			MemberCountShouldBe(c2, 3)
 
-			msg := "SomeMessage"
-			cluster.SendEngine(msg)
-			Eventually(c1.EngineMsgs).Should(Receive(Equal([]byte(msg))))
-			Eventually(c2.EngineMsgs).Should(Receive(Equal([]byte(msg))))
+			cluster.SendEngine("SOMEMESSAGE", "hi")
+			Eventually(c1.EngineMsgs).Should(Receive(Says(`^SOMEMESSAGE primary "hi"$`)))
+			Eventually(c2.EngineMsgs).Should(Receive(Says(`^SOMEMESSAGE primary "hi"$`)))
+		})
+		It("routes to the nodes addressed", func() {
+			defer func() { Labels = map[string]string{} }()
+			HostName, Labels = "c1", map[string]string{"role": "reader"}
+			c1 := NewCluster()
+			c1.Port = 0
+			defer c1.Stop()
+			Ω(c1.Start()).Should(Succeed())
+			c1.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(cluster)))
+			MemberCountShouldBe(c1, 2)
+			HostName, Labels = "c2", map[string]string{"role": "writer"}
+			c2 := NewCluster()
+			c2.Port = 0
+			defer c2.Stop()
+			Ω(c2.Start()).Should(Succeed())
+			c2.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(cluster)))
+			MemberCountShouldBe(c2, 3)
+
+			Ω(cluster.SendEngineTo("role=reader", "SOMEMESSAGE", "readers")).Should(Succeed())
+			Eventually(c1.EngineMsgs).Should(Receive(Says(`"readers"$`)))
+			Ω(cluster.SendEngineTo("c2,primary", "SOMEMESSAGE", "some")).Should(Succeed())
+			Eventually(c2.EngineMsgs).Should(Receive(Says(`"some"$`)))
+			Eventually(cluster.EngineMsgs).Should(Receive(Says(`"some"$`)))
+			Consistently(c1.EngineMsgs).ShouldNot(Receive())
+			Ω(c2.EngineMsgs).ShouldNot(Receive())
+			Ω(cluster.EngineMsgs).ShouldNot(Receive())
+
+			Ω(cluster.SendEngineTo("role=nobody", "SOMEMESSAGE", "none")).ShouldNot(Succeed())
+			Ω(cluster.SendEngineTo("c1,c2", "SOMECOMMAND", "both")).Should(Succeed())
+			Eventually(c1.EngineMsgs).Should(Receive(Says(`"both"$`)))
+			Eventually(c2.EngineMsgs).Should(Receive(Says(`"both"$`)))
+			Ω(cluster.EngineMsgs).ShouldNot(Receive())
+		})
+		It("encrypts gossip", func() {
+			keyA, _ := GenerateKey()
+			keyB, _ := GenerateKey()
+			defer os.Unsetenv(KeysEnv)
+			os.Setenv(KeysEnv, keyA)
+			HostName = "c1"
+			c1 := NewCluster()
+			c1.Port = 0
+			defer c1.Stop()
+			Ω(c1.Start()).Should(Succeed())
+			HostName = "c2"
+			c2 := NewCluster()
+			c2.Port = 0
+			defer c2.Stop()
+			Ω(c2.Start()).Should(Succeed())
+			Ω(c2.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(c1)))).Should(Succeed())
+			MemberCountShouldBe(c1, 2)
+			a, _ := ParseKey(keyA)
+			Ω(c1.Keys()).Should(Equal([]string{KeyID(a)}))
+
+			By("keeping out nodes with another key")
+			os.Setenv(KeysEnv, keyB)
+			HostName = "c3"
+			c3 := NewCluster()
+			c3.Port = 0
+			defer c3.Stop()
+			Ω(c3.Start()).Should(Succeed())
+			Ω(c3.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(c1)))).ShouldNot(Succeed())
+			Consistently(c1.Count).Should(Equal(2))
+
+			By("rotating to that key")
+			for _, op := range []string{"install", "use"} {
+				Ω(c1.ChangeKey(KeyChange{Op: op, Key: keyB})).Should(Succeed())
+				Ω(c2.ChangeKey(KeyChange{Op: op, Key: keyB})).Should(Succeed())
+			}
+			Ω(c1.ChangeKey(KeyChange{Op: "remove", Key: keyA})).Should(Succeed())
+			Ω(c2.ChangeKey(KeyChange{Op: "remove", Key: keyA})).Should(Succeed())
+			b, _ := ParseKey(keyB)
+			Ω(c1.Keys()).Should(Equal([]string{KeyID(b)}))
+			Ω(c3.Join(fmt.Sprintf("127.0.0.1:%d", BindPort(c1)))).Should(Succeed())
+			MemberCountShouldBe(c1, 3)
+			Ω(cluster.Keys()).Should(BeNil())
 		})
 		It("communicates with twenty", func() {
 			const NUM = 20
@@ -130,10 +387,9 @@ var _ = Describe("Clustering", func() {
 				MemberCountShouldBe(cluster, i+2)
 			}
 
-			msg := "SomeMessage"
-			cluster.SendEngine(msg)
+			cluster.SendEngine("SOMEMESSAGE", "hi")
 			for i := 0; i < NUM; i++ {
-				Eventually(c[i].EngineMsgs).Should(Receive(Equal([]byte(msg))), "Host CTW-%d", i)
+				Eventually(c[i].EngineMsgs).Should(Receive(Says(`^SOMEMESSAGE primary "hi"$`)), "Host CTW-%d", i)
 			}
 		})
 		It("manages state", func() {
@@ -2,6 +2,10 @@ package cluster
 
 const CIRCBUFMAPLEN = 100
 
+// ClusterKey holds series for the cluster as a whole rather than a
+// node.
+const ClusterKey = "*cluster*"
+
 // CircBufMap is a map of arrays that is kept at a fixed length. When
 // at full capacity and another item is added, the oldest item drops
 // off.
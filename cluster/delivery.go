@@ -0,0 +1,298 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/memberlist"
+	log "github.com/sirupsen/logrus"
+)
+
+// Control messages go over memberlist's TCP channel rather than UDP.
+// Each node they're for answers with an ACK carrying the message's ID,
+// and nodes that don't are sent it again. Nodes only act on an ID once,
+// so a retry whose first ACK got lost does no harm. Once a node has
+// acted on one it answers again, with HANDLED, or ERROR if it refused.
+
+var (
+	AckTimeout    = 2 * time.Second // How long to wait for a node's ACK before trying again
+	DeliveryTries = 3
+
+	deliveriesKept = 50              // Recent control messages we report on
+	seenFor        = 5 * time.Minute // How long nodes remember IDs they've acted on
+)
+
+func init() {
+	RegisterMessage("ACK", MessageType{Targeted: true})
+	RegisterMessage("HANDLED", MessageType{Targeted: true})
+}
+
+// NodeDelivery is how getting a control message to one node went.
+type NodeDelivery struct {
+	State  string  `json:"state"` // "sending", "acked", "done", "refused" or "failed"
+	Tries  int     `json:"tries"`
+	Millis float64 `json:"millis,omitempty"` // From first send to the ACK
+	Error  string  `json:"error,omitempty"`  // Why it failed or was refused
+}
+
+// Delivery is a control message we sent, and which nodes confirmed it.
+type Delivery struct {
+	ID     string                  `json:"id"`
+	Type   string                  `json:"type"`
+	Target string                  `json:"target,omitempty"`
+	Sent   time.Time               `json:"sent"`
+	Nodes  map[string]NodeDelivery `json:"nodes"`
+
+	acks map[string]chan struct{} // Closed on each node's ACK
+}
+
+func (d *Delivery) copy() Delivery {
+	c := *d
+	c.Nodes = make(map[string]NodeDelivery, len(d.Nodes))
+	for name, n := range d.Nodes {
+		c.Nodes[name] = n
+	}
+	c.acks = nil
+	return c
+}
+
+// Settled says whether every node has acked or failed.
+func (d Delivery) Settled() bool {
+	for _, n := range d.Nodes {
+		if n.State == "sending" {
+			return false
+		}
+	}
+	return true
+}
+
+// Handled says whether every node has acted on it, refused it or
+// failed.
+func (d Delivery) Handled() bool {
+	for _, n := range d.Nodes {
+		if n.State == "sending" || n.State == "acked" {
+			return false
+		}
+	}
+	return true
+}
+
+// Deliveries gives the control messages we sent lately, newest first.
+func (c *Cluster) Deliveries() []Delivery {
+	c.deliveryMutex.Lock()
+	defer c.deliveryMutex.Unlock()
+	list := make([]Delivery, len(c.deliveries))
+	for i, d := range c.deliveries {
+		list[len(list)-1-i] = d.copy()
+	}
+	return list
+}
+
+// Delivery gives how the control message with ID id is going, if it's
+// one we sent lately.
+func (c *Cluster) Delivery(id string) (Delivery, bool) {
+	c.deliveryMutex.Lock()
+	defer c.deliveryMutex.Unlock()
+	for i := len(c.deliveries) - 1; i >= 0; i-- {
+		if c.deliveries[i].ID == id {
+			return c.deliveries[i].copy(), true
+		}
+	}
+	return Delivery{}, false
+}
+
+// sendReliable sends msg to nodes, us included if there, then waits
+// for their ACKs in the background. The missing nodes fail right away.
+func (c *Cluster) sendReliable(msg *Message, b []byte, nodes []*memberlist.Node, missing []string) {
+	d := &Delivery{
+		ID:     msg.ID,
+		Type:   msg.Type,
+		Target: msg.Target,
+		Sent:   time.Now(),
+		Nodes:  map[string]NodeDelivery{},
+		acks:   map[string]chan struct{}{},
+	}
+	for _, name := range missing {
+		d.Nodes[name] = NodeDelivery{State: "failed", Error: "Not in the cluster"}
+	}
+	for _, node := range nodes {
+		d.Nodes[node.Name] = NodeDelivery{State: "sending"}
+		d.acks[node.Name] = make(chan struct{})
+	}
+	c.deliveryMutex.Lock()
+	c.deliveries = append(c.deliveries, d)
+	if len(c.deliveries) > deliveriesKept {
+		c.deliveries = c.deliveries[len(c.deliveries)-deliveriesKept:]
+	}
+	c.deliveryMutex.Unlock()
+	c.reportDelivery(d)
+
+	self := false
+	for _, node := range nodes {
+		if node.Name == c.Name {
+			self = true
+			continue
+		}
+		go c.deliverTo(node, b, d)
+	}
+	if self {
+		c.Delegate.deliver(*msg)
+		c.acked(msg.ID, c.Name)
+	}
+}
+
+// deliverTo sends b to node until it acks or we run out of tries.
+func (c *Cluster) deliverTo(node *memberlist.Node, b []byte, d *Delivery) {
+	ack := d.acks[node.Name]
+	var err error
+	for try := 1; try <= DeliveryTries; try++ {
+		c.updateDelivery(d, node.Name, func(n *NodeDelivery) { n.Tries = try })
+		err = c.Members.SendToTCP(node, b)
+		select {
+		case <-ack:
+			return
+		case <-time.After(AckTimeout):
+		}
+		if err == nil {
+			err = fmt.Errorf("No ACK in %s", AckTimeout)
+		}
+		log.Warnf("Sending %s %s to %s, try %d: %s", d.Type, d.ID, node.Name, try, err)
+	}
+	c.updateDelivery(d, node.Name, func(n *NodeDelivery) {
+		if n.State == "sending" {
+			n.State, n.Error = "failed", err.Error()
+		}
+	})
+}
+
+// acked records from's ACK of the message id.
+func (c *Cluster) acked(id, from string) {
+	c.settle(id, from, "acked", "")
+}
+
+// handled records from having acted on the message id, or refused it
+// if err isn't "". That it has it at all does for an ACK.
+func (c *Cluster) handled(id, from, err string) {
+	if err != "" {
+		c.settle(id, from, "refused", err)
+	} else {
+		c.settle(id, from, "done", "")
+	}
+}
+
+// settle moves from's delivery of the message id on to state, unless
+// it's already there or past it.
+func (c *Cluster) settle(id, from, state, err string) {
+	c.deliveryMutex.Lock()
+	var d *Delivery
+	for i := len(c.deliveries) - 1; i >= 0 && d == nil; i-- {
+		if c.deliveries[i].ID == id {
+			d = c.deliveries[i]
+		}
+	}
+	c.deliveryMutex.Unlock()
+	if d == nil {
+		return // Too old, or a duplicate from a retry
+	}
+	c.updateDelivery(d, from, func(n *NodeDelivery) {
+		if n.State == state || n.State == "done" || n.State == "refused" {
+			return
+		}
+		if n.State == "sending" || n.State == "failed" {
+			n.Millis = float64(time.Since(d.Sent)) / float64(time.Millisecond)
+			if ack, ok := d.acks[from]; ok {
+				close(ack)
+			}
+		}
+		n.State, n.Error = state, err
+	})
+}
+
+// Handled tells msg's sender how acting on it went: an ERROR if err
+// says it was refused, otherwise, for a control message, HANDLED.
+func (c *Cluster) Handled(msg *Message, err error) {
+	switch {
+	case !msg.isControl():
+		if err != nil {
+			c.Reply(msg, "ERROR", ErrorReply{Error: err.Error(), For: msg.Type})
+		}
+	case msg.Sender == c.Name: // Our own replies don't come back through NotifyMsg
+		if err != nil {
+			c.handled(msg.ID, c.Name, err.Error())
+			c.Reply(msg, "ERROR", ErrorReply{Error: err.Error(), For: msg.Type})
+		} else {
+			c.handled(msg.ID, c.Name, "")
+		}
+	case err != nil:
+		c.answer(msg, "ERROR", ErrorReply{Error: err.Error(), For: msg.Type})
+	default:
+		c.answer(msg, "HANDLED")
+	}
+}
+
+func (c *Cluster) updateDelivery(d *Delivery, node string, update func(*NodeDelivery)) {
+	c.deliveryMutex.Lock()
+	n, ok := d.Nodes[node]
+	if ok {
+		update(&n)
+		d.Nodes[node] = n
+	}
+	c.deliveryMutex.Unlock()
+	if ok {
+		c.reportDelivery(d)
+	}
+}
+
+// reportDelivery tells this node's UI how d is going.
+func (c *Cluster) reportDelivery(d *Delivery) {
+	c.deliveryMutex.Lock()
+	value := d.copy()
+	c.deliveryMutex.Unlock()
+	WS.WriteJSON(map[string]interface{}{"type": "DELIVERY", "node": c.Name, "value": value})
+}
+
+// receive acts on a control message from another node, unless it
+// already has, and acks it.
+func (c *Cluster) receive(msg Message) {
+	c.seenMutex.Lock()
+	_, seen := c.seen[msg.ID]
+	if !seen {
+		now := time.Now()
+		for id, when := range c.seen {
+			if now.Sub(when) > seenFor {
+				delete(c.seen, id)
+			}
+		}
+		c.seen[msg.ID] = now
+	}
+	c.seenMutex.Unlock()
+	if !seen {
+		c.Delegate.deliver(msg)
+	}
+	c.ack(&msg)
+}
+
+// ack tells msg's sender we have it.
+func (c *Cluster) ack(msg *Message) {
+	c.answer(msg, "ACK")
+}
+
+// answer sends msg's sender a typ reply over TCP, so that it gets it.
+func (c *Cluster) answer(msg *Message, typ string, payload ...interface{}) {
+	reply, err := NewMessage(c.Name, msg.Sender, typ, payload...)
+	if err != nil {
+		return
+	}
+	reply.ID = msg.ID
+	b, _ := json.Marshal(reply)
+	for _, member := range c.Members.Members() {
+		if member.Name == msg.Sender {
+			if err := c.Members.SendToTCP(member, b); err != nil {
+				log.Warnf("Sending %s for %s %s to %s: %s", typ, msg.Type, msg.ID, msg.Sender, err)
+			}
+			return
+		}
+	}
+	log.Warnf("Can't send %s for %s %s: %s isn't in the cluster", typ, msg.Type, msg.ID, msg.Sender)
+}
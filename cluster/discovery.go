@@ -0,0 +1,203 @@
+package cluster
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Discovery finds the other hitters to join at startup.
+type Discovery interface {
+	Peers() ([]string, error) // host:port of each
+}
+
+// A Discovery that also knows which address this node should listen
+// on, for when BindAddr isn't set.
+type localAddresser interface {
+	LocalAddr() (string, error)
+}
+
+var (
+	// Discover is how Start finds the rest of the cluster.
+	Discover Discovery = EC2Discovery{}
+
+	// BindAddr is the address to listen for the cluster on. Empty
+	// means ask Discover, or all interfaces if it can't say.
+	BindAddr = ""
+
+	// AdvertiseAddr is the address the other nodes reach this one at.
+	// Empty means the bind address, or when that's all interfaces,
+	// InterfaceAddr's pick.
+	AdvertiseAddr = ""
+)
+
+// EC2Discovery finds running instances tagged Type=hitter in this
+// node's VPC.
+type EC2Discovery struct{}
+
+func (EC2Discovery) Peers() ([]string, error) { return FindEC2Hitters() }
+
+func (EC2Discovery) LocalAddr() (string, error) { return ec2Metadata("/local-ipv4") }
+
+// StaticDiscovery is a fixed list of seeds.
+type StaticDiscovery []string
+
+func (s StaticDiscovery) Peers() ([]string, error) {
+	var peers []string
+	for _, seed := range s {
+		if seed = strings.TrimSpace(seed); seed != "" {
+			peers = append(peers, withPort(seed))
+		}
+	}
+	return peers, nil
+}
+
+// FileDiscovery reads seeds from a file, one per line. Blank lines and
+// lines starting with # are skipped. The file is read on every call,
+// so it can be rewritten while the cluster runs.
+type FileDiscovery string
+
+func (f FileDiscovery) Peers() ([]string, error) {
+	file, err := os.Open(string(f))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var seeds StaticDiscovery
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		seeds = append(seeds, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return seeds.Peers()
+}
+
+// DNSDiscovery looks seeds up in DNS: the A records of Name at
+// ClusterPort, or with SRV set, the targets and ports of Name's SRV
+// records (e.g. a Kubernetes headless service).
+type DNSDiscovery struct {
+	Name string
+	SRV  bool
+}
+
+func (d DNSDiscovery) Peers() ([]string, error) {
+	var peers []string
+	if d.SRV {
+		_, srvs, err := net.LookupSRV("", "", d.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range srvs {
+			peers = append(peers, net.JoinHostPort(strings.TrimSuffix(srv.Target, "."), strconv.Itoa(int(srv.Port))))
+		}
+		return peers, nil
+	}
+	addrs, err := net.LookupHost(d.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		peers = append(peers, net.JoinHostPort(addr, strconv.Itoa(ClusterPort)))
+	}
+	return peers, nil
+}
+
+// ParseDiscovery makes a Discovery from a -discovery setting:
+//
+//	ec2                  instances tagged Type=hitter
+//	none                 no seeds (join with -clusterhost)
+//	static:host1,host2   these seeds
+//	file:path            seeds from a file
+//	dns:name             A records of name
+//	srv:name             SRV records of name
+//
+// Seeds without a port are at ClusterPort.
+func ParseDiscovery(spec string) (Discovery, error) {
+	kind, arg := spec, ""
+	if i := strings.Index(spec, ":"); i >= 0 {
+		kind, arg = spec[:i], spec[i+1:]
+	}
+	switch kind {
+	case "ec2":
+		return EC2Discovery{}, nil
+	case "", "none":
+		return StaticDiscovery(nil), nil
+	case "static":
+		return StaticDiscovery(strings.Split(arg, ",")), nil
+	}
+	if arg == "" {
+		return nil, fmt.Errorf("Discovery %q needs a name", spec)
+	}
+	switch kind {
+	case "file":
+		return FileDiscovery(arg), nil
+	case "dns":
+		return DNSDiscovery{Name: arg}, nil
+	case "srv":
+		return DNSDiscovery{Name: arg, SRV: true}, nil
+	}
+	return nil, fmt.Errorf("Unknown discovery %q", spec)
+}
+
+// InterfaceAddr picks an address for the other nodes to reach this
+// one at from the OS's interfaces: the first IPv4 address of one
+// that's up, or loopback if that's all there is, as in a lab on one
+// box. memberlist can't pick one itself outside private networks.
+func InterfaceAddr() (string, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return "", err
+	}
+	loopback := ""
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 {
+			continue
+		}
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			ipnet, ok := addr.(*net.IPNet)
+			if !ok || ipnet.IP.To4() == nil {
+				continue
+			}
+			if !ipnet.IP.IsLoopback() {
+				return ipnet.IP.String(), nil
+			}
+			if loopback == "" {
+				loopback = ipnet.IP.String()
+			}
+		}
+	}
+	if loopback == "" {
+		return "", fmt.Errorf("No IPv4 address to advertise to the cluster; set -advertiseaddr")
+	}
+	return loopback, nil
+}
+
+func withPort(host string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	return net.JoinHostPort(host, strconv.Itoa(ClusterPort))
+}
+
+// OSHostName is the name to use in the cluster when there's no
+// -hostname and no EC2: the OS's hostname, or failing that, "hitter".
+func OSHostName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		return "hitter"
+	}
+	return host
+}
@@ -0,0 +1,200 @@
+package cluster
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+var (
+	// KeysFile holds the keys gossip is encrypted with, base64, one a
+	// line, the first the one to encrypt with. Without it they come
+	// from the KeysEnv environment variable, comma separated. No keys
+	// means gossip goes in the clear.
+	KeysFile = ""
+	KeysEnv  = "HITTER_GOSSIP_KEYS"
+)
+
+// KeyChange is the payload of a GOSSIPKEY: install a key alongside the
+// others, use it to encrypt, or remove it. Rotating goes install, use,
+// then remove the old one, each on every node before the next.
+type KeyChange struct {
+	Op  string `json:"op"`  // install, use or remove
+	Key string `json:"key"` // base64
+}
+
+func init() {
+	RegisterMessage("GOSSIPKEY", MessageType{Engine: true, Control: true, Role: Admin,
+		Payload: func() interface{} { return new(KeyChange) },
+		Check: func(p interface{}) error {
+			change := p.(*KeyChange)
+			if change.Op != "install" && change.Op != "use" && change.Op != "remove" {
+				return fmt.Errorf("Unknown gossip key change %q", change.Op)
+			}
+			_, err := ParseKey(change.Key)
+			return err
+		},
+	})
+}
+
+func ParseKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("Bad gossip key: %s", err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	}
+	return nil, fmt.Errorf("Gossip keys need to be 16, 24 or 32 bytes, not %d", len(key))
+}
+
+// GenerateKey makes a new random key.
+func GenerateKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// KeyID names a key without giving it away.
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// LoadKeys reads the keys from KeysFile or KeysEnv.
+func LoadKeys() ([][]byte, error) {
+	var lines []string
+	if KeysFile != "" {
+		file, err := os.Open(KeysFile)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	} else {
+		lines = strings.Split(os.Getenv(KeysEnv), ",")
+	}
+	var keys [][]byte
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := ParseKey(line)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Encrypted says whether gossip between nodes is encrypted.
+func (c *Cluster) Encrypted() bool {
+	return c.keyring != nil
+}
+
+// Keys gives the IDs of the keys we take gossip in, the one we send
+// it in first. None means it's in the clear.
+func (c *Cluster) Keys() []string {
+	if c.keyring == nil {
+		return nil
+	}
+	primary := c.keyring.GetPrimaryKey()
+	ids := []string{KeyID(primary)}
+	for _, key := range c.keyring.GetKeys() {
+		if string(key) != string(primary) {
+			ids = append(ids, KeyID(key))
+		}
+	}
+	return ids
+}
+
+// ReloadKeys makes our keys those in KeysFile or KeysEnv, as after
+// editing them for a rotation.
+func (c *Cluster) ReloadKeys() error {
+	if c.keyring == nil {
+		return fmt.Errorf("Gossip isn't encrypted; restart with keys to encrypt it")
+	}
+	keys, err := LoadKeys()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("No gossip keys; not going back to the clear")
+	}
+	for _, key := range keys {
+		if err := c.keyring.AddKey(key); err != nil {
+			return err
+		}
+	}
+	if err := c.keyring.UseKey(keys[0]); err != nil {
+		return err
+	}
+	for _, old := range c.keyring.GetKeys() {
+		keep := false
+		for _, key := range keys {
+			keep = keep || string(key) == string(old)
+		}
+		if !keep {
+			if err := c.keyring.RemoveKey(old); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ChangeKey makes a GOSSIPKEY change to our keys, saving them to
+// KeysFile if there is one.
+func (c *Cluster) ChangeKey(change KeyChange) error {
+	if c.keyring == nil {
+		return fmt.Errorf("Gossip isn't encrypted; restart with keys to encrypt it")
+	}
+	key, err := ParseKey(change.Key)
+	if err != nil {
+		return err
+	}
+	switch change.Op {
+	case "install":
+		err = c.keyring.AddKey(key)
+	case "use":
+		err = c.keyring.UseKey(key)
+	case "remove":
+		err = c.keyring.RemoveKey(key)
+	default:
+		err = fmt.Errorf("Unknown gossip key change %q", change.Op)
+	}
+	if err != nil {
+		return err
+	}
+	return c.saveKeys()
+}
+
+func (c *Cluster) saveKeys() error {
+	if KeysFile == "" {
+		return nil
+	}
+	primary := c.keyring.GetPrimaryKey()
+	lines := []string{base64.StdEncoding.EncodeToString(primary)}
+	for _, key := range c.keyring.GetKeys() {
+		if string(key) != string(primary) {
+			lines = append(lines, base64.StdEncoding.EncodeToString(key))
+		}
+	}
+	return ioutil.WriteFile(KeysFile, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+}
@@ -0,0 +1,171 @@
+package cluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// ProtocolVersion is the Message format nodes and the UI speak. Nodes
+// refuse messages of any other version.
+const ProtocolVersion = 1
+
+// Message is everything sent between nodes, and from the UI to them.
+type Message struct {
+	Version int             `json:"v"`
+	Type    string          `json:"type"`
+	Sender  string          `json:"sender"`           // Node that sent it, or whose UI did
+	Target  string          `json:"target,omitempty"` // Address of the nodes it's for; empty for every node
+	ID      string          `json:"id,omitempty"`     // Replies carry the ID of what they reply to
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// MessageType says what messages of a type carry and where they go.
+type MessageType struct {
+	Engine   bool                            // For the engine; otherwise for the UI
+	Control  bool                            // Sent reliably to each node, which acks it
+	Targeted bool                            // Needs a Target, if only "*"
+	Role     Role                            // Least role a user needs to send it
+	Payload  func() interface{}              // A new value to decode the payload into; nil for no payload
+	Check    func(payload interface{}) error // Validates the decoded payload, if set
+}
+
+var messageTypes = map[string]MessageType{}
+
+// RegisterMessage makes a message type known. Messages of unknown
+// types are refused. Users need t.Role to send it, or to be admins if
+// it has none: what nodes tell each other isn't for anyone else.
+func RegisterMessage(name string, t MessageType) {
+	if t.Role == NoRole {
+		t.Role = Admin
+	}
+	messageTypes[name] = t
+}
+
+// ErrorReply is the payload of an ERROR, sent back to whoever sent a
+// message that was refused or failed.
+type ErrorReply struct {
+	Error string `json:"error"`
+	For   string `json:"for"` // The type of the message
+}
+
+func init() {
+	RegisterMessage("ERROR", MessageType{Payload: func() interface{} { return new(ErrorReply) }})
+	RegisterMessage("LOG", MessageType{Payload: func() interface{} { return new(string) }})
+	RegisterMessage("NODEQPS", MessageType{Engine: true, Control: true, Targeted: true, Role: Operator,
+		Payload: func() interface{} { return new(NodeQPS) },
+		Check: func(p interface{}) error {
+			if q := p.(*NodeQPS); q.Share < 0 || q.Total < 1 {
+				return fmt.Errorf("Bad node QPS share %d of %d", q.Share, q.Total)
+			}
+			return nil
+		},
+	})
+}
+
+// NodeQPS is a node's share of the cluster QPS target.
+type NodeQPS struct {
+	Share int `json:"share"`
+	Total int `json:"total"`
+}
+
+var (
+	messageIDs uint64
+	// Tells this run's message IDs from the last one's, which nodes
+	// may still remember having seen.
+	bootID = strconv.FormatInt(time.Now().UnixNano(), 36)
+)
+
+// NewMessage makes a message of type typ from sender, with payload if
+// there is one.
+func NewMessage(sender, target, typ string, payload ...interface{}) (*Message, error) {
+	m := &Message{
+		Version: ProtocolVersion,
+		Type:    typ,
+		Sender:  sender,
+		Target:  target,
+		ID:      fmt.Sprintf("%s-%s-%d", sender, bootID, atomic.AddUint64(&messageIDs, 1)),
+	}
+	if len(payload) > 0 {
+		b, err := json.Marshal(payload[0])
+		if err != nil {
+			return nil, err
+		}
+		m.Payload = b
+	}
+	return m, m.Validate()
+}
+
+func (m *Message) hasPayload() bool {
+	return len(m.Payload) > 0 && !bytes.Equal(m.Payload, []byte("null"))
+}
+
+// Validate checks the message is one we know, properly addressed,
+// with the payload its type takes.
+func (m *Message) Validate() error {
+	if m.Version != ProtocolVersion {
+		return fmt.Errorf("Protocol version %d not supported (want %d)", m.Version, ProtocolVersion)
+	}
+	t, ok := messageTypes[m.Type]
+	if !ok {
+		return fmt.Errorf("Unknown message type %q", m.Type)
+	}
+	if m.Sender == "" {
+		return fmt.Errorf("%s has no sender", m.Type)
+	}
+	if t.Targeted && m.Target == "" {
+		return fmt.Errorf("%s needs a target node", m.Type)
+	}
+	if _, err := ParseAddress(m.Target); err != nil {
+		return err
+	}
+	if t.Payload == nil {
+		if m.hasPayload() {
+			return fmt.Errorf("%s takes no payload", m.Type)
+		}
+		return nil
+	}
+	if !m.hasPayload() {
+		return fmt.Errorf("%s needs a payload", m.Type)
+	}
+	payload := t.Payload()
+	if err := json.Unmarshal(m.Payload, payload); err != nil {
+		return fmt.Errorf("Bad %s payload: %s", m.Type, err)
+	}
+	if t.Check != nil {
+		return t.Check(payload)
+	}
+	return nil
+}
+
+// Decode puts the payload into v.
+func (m *Message) Decode(v interface{}) error {
+	return json.Unmarshal(m.Payload, v)
+}
+
+// For says whether the node called name, with labels, should act on
+// m.
+func (m *Message) For(name string, labels map[string]string) bool {
+	a, err := ParseAddress(m.Target)
+	return err == nil && a.Matches(name, labels)
+}
+
+func (m *Message) isEngine() bool {
+	return messageTypes[m.Type].Engine
+}
+
+func (m *Message) isControl() bool {
+	return IsControl(m.Type)
+}
+
+// IsControl says whether messages of type typ are control messages.
+func IsControl(typ string) bool {
+	return messageTypes[typ].Control
+}
+
+func (m Message) String() string {
+	return fmt.Sprintf("%s %s %s", m.Type, m.Sender, m.Payload)
+}
@@ -0,0 +1,45 @@
+package cluster
+
+import "fmt"
+
+// Role is what a UI or API user may do. Each role can do everything
+// the ones before it can.
+type Role int
+
+const (
+	NoRole   Role = iota
+	Viewer        // Watch
+	Operator      // Start, stop, and set QPS, procs and the like
+	Admin         // Kill nodes, switch and change databases
+)
+
+var roleNames = []string{"none", "viewer", "operator", "admin"}
+
+func (r Role) String() string {
+	if r < NoRole || int(r) >= len(roleNames) {
+		return fmt.Sprintf("role%d", int(r))
+	}
+	return roleNames[r]
+}
+
+func ParseRole(s string) (Role, error) {
+	for i, name := range roleNames {
+		if i > 0 && name == s {
+			return Role(i), nil
+		}
+	}
+	return NoRole, fmt.Errorf("Unknown role %q (want viewer, operator or admin)", s)
+}
+
+// Allowed says whether someone with role may send messages of type
+// typ, and if not, why not.
+func Allowed(role Role, typ string) error {
+	t, ok := messageTypes[typ]
+	if !ok {
+		return fmt.Errorf("Unknown message type %q", typ)
+	}
+	if role < t.Role {
+		return fmt.Errorf("%s needs the %s role", typ, t.Role)
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/bouk/monkey"
 	. "github.com/lyfe-mobile/hitter/common"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 	mgo "gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 	"gopkg.in/mgo.v2/dbtest"
@@ -25,6 +26,11 @@ func MemberCountShouldBe(c *Cluster, count int) {
 	EventuallyWithOffset(1, c.Count).Should(BeNumerically("==", count))
 }
 
+// Says matches a Message whose String() matches regexp.
+func Says(regexp string) types.GomegaMatcher {
+	return WithTransform(func(m Message) string { return m.String() }, MatchRegexp(regexp))
+}
+
 func FakeEC2Metadata(d *ec2metadata.EC2Metadata, s string) (string, error) {
 	if s == "/local-ipv4" {
 		return "127.0.0.1", nil
@@ -79,12 +85,12 @@ func StartTestServer(tempDir string) *TestServer {
 		"582b2f32b1490312c2f5f679",
 	}
 	// Seed campaigns
-	campColl := ts.DbSession.DB(MONGODB[WHICHDB]).C("campaign")
+	campColl := ts.DbSession.DB(DBName()).C("campaign")
 	for _, camp := range campaigns {
 		Ω(campColl.Insert(bson.M{"_id": bson.ObjectIdHex(camp)})).Should(Succeed())
 	}
 	// Seed advertiser
-	advColl := ts.DbSession.DB(MONGODB[WHICHDB]).C("advertiser")
+	advColl := ts.DbSession.DB(DBName()).C("advertiser")
 	Ω(advColl.Insert(bson.M{"username": "mbolllinger@rhythmone.com", "funds": 20341.0})).Should(Succeed())
 	ts.wg.Add(1)
 	go func() {
@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -34,17 +35,61 @@ func (ws *WebSockets) WriteJSON(message map[string]interface{}) {
 	}
 }
 
-func (ws *WebSockets) listen(socket *websocket.Conn) {
+// writeTo sends message to one socket only.
+func (ws *WebSockets) writeTo(socket *websocket.Conn, message map[string]interface{}) {
+	ws.sockLock.Lock()
+	defer ws.sockLock.Unlock()
+	if err := socket.WriteJSON(message); err != nil {
+		Log("%s", err)
+	}
+}
+
+// listen passes the UI's messages on to the cluster as from this node,
+// answering any it refuses, or that role may not send, with an ERROR.
+// Only the type, target and payload are the UI's: what's sent is a new
+// message, so that UIs can't reuse each other's IDs. The UI's own ID
+// only goes back in that ERROR.
+func (ws *WebSockets) listen(socket *websocket.Conn, role Role) {
 	for {
-		_, message, err := socket.ReadMessage()
+		_, b, err := socket.ReadMessage()
 		if err != nil {
 			break
 		}
-		Clus.SendEngine(string(message))
+		var ui Message
+		if err = json.Unmarshal(b, &ui); err == nil {
+			err = Allowed(role, ui.Type)
+		}
+		if err == nil {
+			var msg *Message
+			if msg, err = fromUI(ui); err == nil {
+				err = Clus.Send(msg)
+			}
+		}
+		if err != nil {
+			ws.writeTo(socket, map[string]interface{}{
+				"type":  "ERROR",
+				"node":  Clus.Name,
+				"id":    ui.ID,
+				"value": ErrorReply{Error: err.Error(), For: ui.Type},
+			})
+		}
+	}
+}
+
+// fromUI makes the message this node sends for ui, one from its UI.
+func fromUI(ui Message) (*Message, error) {
+	if ui.Version != ProtocolVersion {
+		return nil, fmt.Errorf("Protocol version %d not supported (want %d)", ui.Version, ProtocolVersion)
+	}
+	var payload []interface{}
+	if ui.hasPayload() {
+		payload = append(payload, ui.Payload)
 	}
+	return NewMessage(Clus.Name, ui.Target, ui.Type, payload...)
 }
 
-func (ws *WebSockets) ServeWs(w http.ResponseWriter, r *http.Request) {
+// ServeWs upgrades a UI connection to a websocket. Its user has role.
+func (ws *WebSockets) ServeWs(w http.ResponseWriter, r *http.Request, role Role) {
 	var upgrader = websocket.Upgrader{}
 	socket, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -55,7 +100,7 @@ func (ws *WebSockets) ServeWs(w http.ResponseWriter, r *http.Request) {
 	ws.listeners = append(ws.listeners, socket)
 	ws.sockLock.Unlock()
 	// TODO: Attach listener here
-	go ws.listen(socket)
+	go ws.listen(socket, role)
 }
 
 var WS = WebSockets{}
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	mgo "gopkg.in/mgo.v2"
+)
+
+// WRITECONCERN is what writes wait for, in ParseWriteConcern's
+// format.
+var WRITECONCERN = "w0"
+
+var WriteConcerns = []string{"w0", "w1", "majority", "journaled", "majority,wtimeout=5000"}
+
+// ParseWriteConcern turns "w0", "w1", "majority" or "journaled",
+// optionally followed by ",wtimeout=<ms>", into what to give
+// Session.SetSafe. A bare "wtimeout=<ms>" means majority with that
+// timeout.
+//
+// w0 is nil, meaning unacknowledged: mgo still waits on getLastError
+// for a Safe with W 0.
+func ParseWriteConcern(spec string) (*mgo.Safe, error) {
+	var safe *mgo.Safe
+	var timeout int
+	for i, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, "wtimeout=") {
+			ms, err := strconv.Atoi(part[len("wtimeout="):])
+			if err != nil || ms <= 0 {
+				return nil, fmt.Errorf("Bad wtimeout in write concern %q", spec)
+			}
+			timeout = ms
+			continue
+		}
+		if i > 0 {
+			return nil, fmt.Errorf("Unknown write concern option %q in %q", part, spec)
+		}
+		switch part {
+		case "w0":
+		case "w1":
+			safe = &mgo.Safe{W: 1}
+		case "majority":
+			safe = &mgo.Safe{WMode: "majority"}
+		case "journaled":
+			safe = &mgo.Safe{W: 1, J: true}
+		default:
+			return nil, fmt.Errorf("Unknown write concern %q", spec)
+		}
+	}
+	if timeout > 0 {
+		if safe == nil {
+			if !strings.HasPrefix(strings.TrimSpace(spec), "wtimeout=") {
+				return nil, fmt.Errorf("wtimeout needs acknowledged writes in %q", spec)
+			}
+			safe = &mgo.Safe{WMode: "majority"}
+		}
+		safe.WTimeout = timeout
+	}
+	return safe, nil
+}
@@ -0,0 +1,386 @@
+// Package ctl is hitter ctl, a command-line client for a running
+// cluster, for when there's no browser to hand. It drives the cluster
+// through a node's REST API and websocket.
+package ctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/common"
+)
+
+const usage = `Usage: hitter ctl [flags] <command> [args]
+
+Commands:
+  status                       the nodes and what they're doing
+  start [nodes]                start load
+  stop [nodes]                 stop load
+  qps N [nodes]                set the QPS target per node
+  procs N [nodes]              set the processes per node
+  coll LETTER on|off [nodes]   start or stop loading a collection
+  db NAME                      switch every node to a DB target
+  tail logs [nodes]            follow the nodes' logs
+  watch [nodes]                follow QPS, a line a second
+
+nodes is an address: a node, several (hitter-1,hitter-2), labels
+(role=reader), or * for all, as by default.
+
+Flags:
+`
+
+// Client talks to one node of the cluster.
+type Client struct {
+	Server   string // Base URL of the node's UI
+	Token    string
+	User     string // user:password, if not using a token
+	JSON     bool   // Print JSON rather than text
+	Out      io.Writer
+	Interval time.Duration // How often watch prints
+}
+
+// Main runs hitter ctl with args (those after "ctl"), giving the exit
+// code.
+func Main(args []string) int {
+	c := &Client{Out: os.Stdout, Interval: time.Second}
+	fs := flag.NewFlagSet("hitter ctl", flag.ContinueOnError)
+	fs.StringVar(&c.Server, "server", fmt.Sprintf("http://localhost:%d", common.WEBPORT), "URL of any node's UI")
+	fs.StringVar(&c.Token, "token", os.Getenv("HITTER_TOKEN"), "API token (default $HITTER_TOKEN)")
+	fs.StringVar(&c.User, "user", "", "Log in as user:password instead of with a token")
+	fs.BoolVar(&c.JSON, "json", false, "Print JSON")
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+	ok, err := c.Run(fs.Arg(0), fs.Args()[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "hitter ctl %s: %s\n", fs.Arg(0), err)
+		return 1
+	}
+	if !ok {
+		return 1
+	}
+	return 0
+}
+
+// Run carries out one command, saying whether every node it went to
+// took it.
+func (c *Client) Run(cmd string, args []string) (bool, error) {
+	arg := func(i int) string {
+		if i < len(args) {
+			return args[i]
+		}
+		return ""
+	}
+	switch cmd {
+	case "status":
+		return true, c.Status()
+	case "start":
+		return c.Command("START", arg(0), nil)
+	case "stop":
+		return c.Command("STOP", arg(0), nil)
+	case "qps", "procs":
+		n, err := strconv.Atoi(arg(0))
+		if err != nil {
+			return false, fmt.Errorf("Need a number, not %q", arg(0))
+		}
+		typ := map[string]string{"qps": "TARGETQPS", "procs": "PROCS"}[cmd]
+		return c.Command(typ, arg(1), n)
+	case "coll":
+		typ := map[string]string{"on": "COLLSTART", "off": "COLLSTOP"}[arg(1)]
+		if typ == "" {
+			return false, fmt.Errorf("Need a collection letter and on or off")
+		}
+		return c.Command(typ, arg(2), strings.ToUpper(arg(0)))
+	case "db":
+		if arg(0) == "" {
+			return false, fmt.Errorf("Which DB target?")
+		}
+		return c.Command("DB", "", arg(0))
+	case "tail":
+		if arg(0) == "logs" { // The only thing to tail, so it can be left out
+			args = args[1:]
+		}
+		return true, c.Tail(arg(0))
+	case "watch":
+		return true, c.Watch(arg(0))
+	}
+	return false, fmt.Errorf("Unknown command (see hitter ctl -h)")
+}
+
+func (c *Client) auth(header http.Header) {
+	if c.Token != "" {
+		header.Set("Authorization", "Bearer "+c.Token)
+	} else if c.User != "" {
+		req := http.Request{Header: header}
+		parts := strings.SplitN(c.User, ":", 2)
+		req.SetBasicAuth(parts[0], strings.Join(parts[1:], ""))
+	}
+}
+
+// call makes an API request, decoding the answer into v.
+func (c *Client) call(method, path string, body, v interface{}) error {
+	var in io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		in = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, strings.TrimSuffix(c.Server, "/")+"/api/v1/"+path, in)
+	if err != nil {
+		return err
+	}
+	c.auth(req.Header)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&apiErr) != nil || apiErr.Error == "" {
+			apiErr.Error = resp.Status
+		}
+		return fmt.Errorf("%s", strings.TrimSpace(apiErr.Error))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (c *Client) printJSON(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.Out, "%s\n", b)
+	return err
+}
+
+// node is what the API says of each node.
+type node struct {
+	Name       string            `json:"name"`
+	State      string            `json:"state"`
+	TargetQPS  int               `json:"targetqps"`
+	Procs      int               `json:"procs"`
+	QPSHistory [][]float64       `json:"qpshistory"` // [unix ms, QPS]
+	Labels     map[string]string `json:"labels"`
+}
+
+// Status prints the cluster's settings and each node's state.
+func (c *Client) Status() error {
+	var state struct {
+		WhichDB      string `json:"whichdb"`
+		WriteConcern string `json:"writeconcern"`
+		ClusterQPS   int    `json:"clusterqps"`
+		Nodes        []node `json:"nodes"`
+	}
+	var raw json.RawMessage
+	if err := c.call("GET", "cluster", nil, &raw); err != nil {
+		return err
+	}
+	if c.JSON {
+		return c.printJSON(raw)
+	}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.Out, "DB %s, write concern %s", state.WhichDB, state.WriteConcern)
+	if state.ClusterQPS > 0 {
+		fmt.Fprintf(c.Out, ", cluster QPS target %d", state.ClusterQPS)
+	}
+	fmt.Fprintln(c.Out)
+	w := tabwriter.NewWriter(c.Out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tSTATE\tQPS\tTARGET\tPROCS\tLABELS")
+	for _, n := range state.Nodes {
+		qps := "-"
+		if len(n.QPSHistory) > 0 && len(n.QPSHistory[len(n.QPSHistory)-1]) == 2 {
+			qps = fmt.Sprintf("%.0f", n.QPSHistory[len(n.QPSHistory)-1][1])
+		}
+		var labels []string
+		for key, value := range n.Labels {
+			labels = append(labels, key+"="+value)
+		}
+		sort.Strings(labels)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n", n.Name, n.State, qps, n.TargetQPS, n.Procs, strings.Join(labels, ","))
+	}
+	return w.Flush()
+}
+
+// CommandResult is what the API says of a command.
+type CommandResult struct {
+	ID     string                          `json:"id"`
+	Type   string                          `json:"type"`
+	Target string                          `json:"target"`
+	OK     bool                            `json:"ok"`
+	Nodes  map[string]cluster.NodeDelivery `json:"nodes"`
+}
+
+// Command sends a command of type typ to the nodes at target (all of
+// them if it's empty), and prints how each took it.
+func (c *Client) Command(typ, target string, payload interface{}) (bool, error) {
+	if target == "" {
+		target = "*"
+	}
+	body := map[string]interface{}{"type": typ, "target": target}
+	if payload != nil {
+		body["payload"] = payload
+	}
+	var result CommandResult
+	if err := c.call("POST", "commands", body, &result); err != nil {
+		return false, err
+	}
+	if c.JSON {
+		return result.OK, c.printJSON(result)
+	}
+	status := "ok"
+	if !result.OK {
+		status = "NOT ok"
+	}
+	fmt.Fprintf(c.Out, "%s %s: %s\n", result.Type, result.Target, status)
+	var names []string
+	for name := range result.Nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w := tabwriter.NewWriter(c.Out, 0, 8, 2, ' ', 0)
+	for _, name := range names {
+		n := result.Nodes[name]
+		detail := n.Error
+		if n.State == "done" {
+			detail = fmt.Sprintf("%.1fms", n.Millis)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%d tries\t%s\n", name, n.State, n.Tries, detail)
+	}
+	return result.OK, w.Flush()
+}
+
+// matcher says whether messages from a node are from one at address,
+// every node it names being in the cluster.
+func (c *Client) matcher(address string) (func(name string) bool, error) {
+	a, err := cluster.ParseAddress(address)
+	if err != nil || a.All() {
+		return func(string) bool { return true }, err
+	}
+	var nodes []node
+	if err := c.call("GET", "nodes", nil, &nodes); err != nil {
+		return nil, err
+	}
+	labels := map[string]map[string]string{}
+	for _, n := range nodes {
+		labels[n.Name] = n.Labels
+	}
+	for _, name := range a.Nodes {
+		if _, ok := labels[name]; !ok {
+			return nil, fmt.Errorf("No node %s in the cluster", name)
+		}
+	}
+	return func(name string) bool { return a.Matches(name, labels[name]) }, nil
+}
+
+// uiMessage is what the UI's websocket carries.
+type uiMessage struct {
+	Type  string          `json:"type"`
+	Node  string          `json:"node"`
+	Value json.RawMessage `json:"value"`
+}
+
+// follow calls handle with each message the UI gets from the nodes at
+// address, until the connection drops.
+func (c *Client) follow(address string, handle func(uiMessage) error) error {
+	match, err := c.matcher(address)
+	if err != nil {
+		return err
+	}
+	url := strings.TrimSuffix(c.Server, "/") + "/ws"
+	url = "ws" + strings.TrimPrefix(url, "http")
+	header := http.Header{}
+	c.auth(header)
+	conn, _, err := websocket.DefaultDialer.Dial(url, header)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	for {
+		var msg uiMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			return err
+		}
+		if match(msg.Node) {
+			if err := handle(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Tail prints the nodes' log lines as they come.
+func (c *Client) Tail(address string) error {
+	return c.follow(address, func(msg uiMessage) error {
+		if msg.Type != "LOG" {
+			return nil
+		}
+		if c.JSON {
+			return json.NewEncoder(c.Out).Encode(msg)
+		}
+		var line string
+		json.Unmarshal(msg.Value, &line)
+		_, err := fmt.Fprintf(c.Out, "%s %s: %s\n", time.Now().Format("15:04:05"), msg.Node, line)
+		return err
+	})
+}
+
+// Watch prints the nodes' QPS, and their total, every Interval.
+func (c *Client) Watch(address string) error {
+	qps := map[string]float64{}
+	last := time.Now()
+	return c.follow(address, func(msg uiMessage) error {
+		if msg.Type != "QPS" {
+			return nil
+		}
+		var point [2]float64 // Unix ms, QPS
+		json.Unmarshal(msg.Value, &point)
+		if c.JSON {
+			return json.NewEncoder(c.Out).Encode(map[string]interface{}{"node": msg.Node, "ts": point[0], "qps": point[1]})
+		}
+		qps[msg.Node] = point[1]
+		if time.Since(last) < c.Interval {
+			return nil
+		}
+		last = time.Now()
+		var names []string
+		total := 0.0
+		for name, q := range qps {
+			names = append(names, name)
+			total += q
+		}
+		sort.Strings(names)
+		line := fmt.Sprintf("%s  total %6.0f", last.Format("15:04:05"), total)
+		for _, name := range names {
+			line += fmt.Sprintf("  %s %.0f", name, qps[name])
+		}
+		_, err := fmt.Fprintln(c.Out, line)
+		return err
+	})
+}
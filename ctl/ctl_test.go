@@ -0,0 +1,102 @@
+package ctl_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/lyfe-mobile/hitter/ctl"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ctl", func() {
+	var (
+		server *httptest.Server
+		client *Client
+		out    *bytes.Buffer
+		sent   map[string]interface{}
+	)
+	BeforeEach(func() {
+		sent = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer sesame" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error": "Unknown token"}`))
+				return
+			}
+			switch r.URL.Path {
+			case "/api/v1/cluster":
+				w.Write([]byte(`{"whichdb": "local", "writeconcern": "w1", "nodes": [
+					{"name": "hitter-1", "state": "play", "targetqps": 1000, "procs": 4,
+					 "qpshistory": [[1500000000000, 990], [1500000001000, 1003]], "labels": {"role": "reader"}},
+					{"name": "hitter-2", "state": "stop", "targetqps": 1000, "procs": 4, "qpshistory": []}]}`))
+			case "/api/v1/nodes":
+				w.Write([]byte(`[{"name": "hitter-1", "labels": {"role": "reader"}}, {"name": "hitter-2"}]`))
+			case "/api/v1/commands":
+				sent = nil // Decode would merge into the last one
+				json.NewDecoder(r.Body).Decode(&sent)
+				w.Write([]byte(`{"id": "hitter-1-3", "type": "TARGETQPS", "target": "role=reader", "ok": false, "nodes": {
+					"hitter-1": {"state": "done", "tries": 1, "millis": 0.4},
+					"hitter-3": {"state": "failed", "tries": 3, "error": "No ACK in 2s"}}}`))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+		out = &bytes.Buffer{}
+		client = &Client{Server: server.URL, Token: "sesame", Out: out}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("shows status", func() {
+		Ω(client.Run("status", nil)).Should(BeTrue())
+		Ω(out.String()).Should(MatchRegexp(`DB local, write concern w1\n`))
+		Ω(out.String()).Should(MatchRegexp(`hitter-1 +play +1003 +1000 +4 +role=reader\n`))
+		Ω(out.String()).Should(MatchRegexp(`hitter-2 +stop +- +1000 +4`))
+	})
+	It("sends commands", func() {
+		Ω(client.Run("qps", []string{"500", "role=reader"})).Should(BeFalse())
+		Ω(sent).Should(Equal(map[string]interface{}{"type": "TARGETQPS", "target": "role=reader", "payload": 500.0}))
+		Ω(out.String()).Should(ContainSubstring("TARGETQPS role=reader: NOT ok"))
+		Ω(out.String()).Should(MatchRegexp(`hitter-3 +failed +3 tries +No ACK in 2s`))
+		Ω(out.String()).Should(MatchRegexp(`hitter-1 +done +1 tries +0.4ms`))
+
+		client.Run("coll", []string{"t", "off"})
+		Ω(sent).Should(Equal(map[string]interface{}{"type": "COLLSTOP", "target": "*", "payload": "T"}))
+		client.Run("start", nil)
+		Ω(sent).Should(Equal(map[string]interface{}{"type": "START", "target": "*"}))
+
+		_, err := client.Run("qps", []string{"lots"})
+		Ω(err).Should(HaveOccurred())
+	})
+	It("follows only nodes that are there", func() {
+		_, err := client.Run("tail", []string{"logs", "hitter-1,hitter-9"})
+		Ω(err).Should(MatchError("No node hitter-9 in the cluster"))
+		_, err = client.Run("watch", []string{"hitter-9"})
+		Ω(err).Should(MatchError("No node hitter-9 in the cluster"))
+		_, err = client.Run("tail", []string{"role=reader,"})
+		Ω(err).Should(HaveOccurred())
+	})
+	It("prints JSON", func() {
+		client.JSON = true
+		client.Run("stop", []string{"hitter-1,hitter-3"})
+		var result CommandResult
+		Ω(json.Unmarshal(out.Bytes(), &result)).Should(Succeed())
+		Ω(result.Nodes["hitter-3"].Error).Should(Equal("No ACK in 2s"))
+	})
+	It("says when it's turned away", func() {
+		client.Token = "guess"
+		_, err := client.Run("status", nil)
+		Ω(err).Should(MatchError("Unknown token"))
+	})
+})
+
+// Ginkgo boilerplate, this runs all tests in this package
+func TestSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Ctl Tests")
+}
@@ -1,32 +1,73 @@
 package engine
 
 import (
+	"fmt"
 	"os"
-	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/lyfe-mobile/hitter/cluster"
 	. "github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/stats"
 )
 
 var (
-	Running bool
-	MyQPS   uint64
+	Running  bool
+	MyQPS    uint64
+	LastQPS  uint64                 // The last full second's MyQPS
+	InFlight int64                  // Operations waiting on Mongo right now
+	Latency  = stats.NewRecorder()  // Of every Upsert/Update
+	Errors   = stats.NewCounters()  // Running totals since startup
+	CollOps  = map[string]*uint64{} // Successful operations per collection since startup
+
+	latencyTotal      = stats.NewHistogram() // Everything Latency has had since startup
+	latencyTotalMutex sync.Mutex
 )
 
+func init() {
+	for _, coll := range LogOrder {
+		CollOps[coll] = new(uint64)
+	}
+}
+
+// LatencyTotal gives a copy of every latency recorded since startup.
+func LatencyTotal() *stats.Histogram {
+	latencyTotalMutex.Lock()
+	defer latencyTotalMutex.Unlock()
+	return latencyTotal.Copy()
+}
+
+// NumProcs is the number of RunLogs goroutines actually going.
+func NumProcs() int {
+	return int(atomic.LoadInt32(&numprocs))
+}
+
 func Ticking(ticker *time.Ticker) bool {
 	_, ok := <-ticker.C
 	return ok
 }
 
 func MonitorQPS() {
+	go watchGuardrails()
 	ticker := time.NewTicker(time.Second)
 	for Ticking(ticker) {
-		qps := atomic.LoadUint64(&MyQPS)
-		atomic.StoreUint64(&MyQPS, 0)
-		cluster.Clus.SendUI("QPS", []uint64{qps, uint64(time.Now().Unix()) * 1000})
+		qps := atomic.SwapUint64(&MyQPS, 0)
+		atomic.StoreUint64(&LastQPS, qps)
+		ts := uint64(time.Now().Unix()) * 1000
+		cluster.Clus.SendUI("QPS", [2]uint64{qps, ts})
+		snapshot := Latency.Snapshot()
+		latencyTotalMutex.Lock()
+		latencyTotal.Merge(snapshot)
+		latencyTotalMutex.Unlock()
+		cluster.Clus.SendUI("LATENCY", LatencyReport{TS: ts, Hist: snapshot})
+		counts := Errors.Counts()
+		reads, readHist := readSecond(ts)
+		recordSecond(ts, qps, snapshot, counts, reads, readHist)
+		noteGuardSecond(snapshot.Percentiles().P99, counts)
+		cluster.Clus.SendUI("ERRORS", counts)
+		cluster.Clus.SendUI("READSTATS", reads)
 	}
 }
 
@@ -38,9 +79,76 @@ var LetterToColl = map[string]string{
 	"T": TdColl,
 }
 
+// Closed to stop this run's readers. Only the engine starts and stops
+// runs, so it needs no lock.
+var readsStop chan bool
+
+func startRun() {
+	beginRecord()
+	Running = true
+	cluster.Clus.SendUI("STARTED")
+	cluster.Log("Started with write concern %s", WRITECONCERN)
+	go func() {
+		for {
+			if !Running {
+				return
+			}
+			MultiRunLogs()
+		}
+	}()
+	readsStop = make(chan bool)
+	go RunReads(readsStop)
+}
+
+func stopRun() {
+	Running = false
+	if readsStop != nil {
+		close(readsStop)
+		readsStop = nil
+	}
+	cluster.Clus.SendUI("STOPPED")
+	endRecord()
+	time.AfterFunc(2*time.Second, sendReplays) // Once the writers have all noticed
+}
+
+// Tell the UI how many times this node has replayed each log, for
+// verification.
+func sendReplays() {
+	cluster.Clus.SendUI("REPLAYS", Replays())
+}
+
+// adoptTargets takes on set if it's newer than our DB targets,
+// reconnecting if the one in use changed.
+func adoptTargets(set TargetSet) {
+	before, _ := Target(WHICHDB)
+	adopted, err := AdoptTargets(set)
+	if err != nil {
+		cluster.Log("Saving DB targets: %s", err)
+	}
+	if !adopted {
+		return
+	}
+	if after, _ := Target(WHICHDB); after != before {
+		DBLock.Lock()
+		LiveDB = nil
+		DBLock.Unlock()
+	}
+	cluster.Clus.SendUI("DBTARGETSSET", set.Version)
+}
+
+// Tell the UI how far off a scheduled start or stop was, by our clock,
+// as "<start|stop> <microseconds late>".
+func reportSkew(what string, at time.Time) {
+	skew := time.Now().Sub(at)
+	cluster.Clus.SendUI("SKEW", fmt.Sprintf("%s %d", what, skew/time.Microsecond))
+	cluster.Log("Scheduled %s was %s from its time", what, skew)
+}
+
 func Engine() {
 	atomic.StoreUint64(&MyQPS, 0)
 
+	var sched schedule
+
 	for {
 		var delay <-chan time.Time
 		if Running {
@@ -53,90 +161,218 @@ func Engine() {
 			if !ok { // UI says we're done.
 				return
 			}
-			cmds := strings.Split(string(msg), " ")
-			switch cmds[0] {
-			case "START":
-				if cmds[1] != cluster.HostName || Running {
-					break
-				}
-				Running = true
-				cluster.Clus.SendUI("STARTED")
-				go func() {
-					for {
-						if !Running {
-							return
-						}
-						MultiRunLogs()
-					}
-				}()
-			case "STOP":
-				if cmds[1] != cluster.HostName || !Running {
-					break
-				}
-				Running = false
-				cluster.Clus.SendUI("STOPPED")
-			case "ONCE": // For testing
-				if Running {
-					break
-				}
-				Running = true
-				MultiRunLogs()
-				Running = false
-				cluster.Clus.SendEngine("DONE")
-			case "PROCS":
-				cluster.PROCS, _ = strconv.Atoi(cmds[1])
-				if Running {
-					AdjustProcs()
-				} else {
-					cluster.Clus.SendUI("PROCSAT", cluster.PROCS)
-				}
-			case "EXIT":
-				close(cluster.Clus.UIMsgs) // Tell UI we're done.
+			if !msg.For(cluster.HostName, cluster.Clus.Labels) {
+				break
+			}
+			err := handle(&msg, &sched)
+			if err != nil {
+				cluster.Log("%s: %s", msg.Type, err)
+			}
+			cluster.Clus.Handled(&msg, err)
+			if msg.Type == "EXIT" {
 				return
-			case "TARGETQPS":
-				cluster.PERSEC, _ = strconv.Atoi(cmds[1])
-				if Running {
-					AdjustProcs()
-				}
-				cluster.Clus.SendUI("TARGETQPSAT", cluster.PERSEC)
-			case "COLLSTART":
-				if cmds[1] == cluster.HostName {
-					EnableColl(LetterToColl[cmds[2]])
-					cluster.Clus.SendUI("COLLSTARTED", cmds[2])
-					// Tell everyone there's been a change.
-					go func(p int32) {
-						for i := 0; i < int(p); i++ {
-							tickerChange <- true
-						}
-					}(numprocs)
-				}
-			case "COLLSTOP":
-				if cmds[1] == cluster.HostName {
-					DisableColl(LetterToColl[cmds[2]])
-					cluster.Clus.SendUI("COLLSTOPPED", cmds[2])
-					// Tell everyone there's been a change.
-					go func(p int32) {
-						for i := 0; i < int(p); i++ {
-							tickerChange <- true
-						}
-					}(numprocs)
-				}
-			case "DB":
-				WHICHDB = cmds[1]
-				DBLock.Lock()
-				LiveDB = nil
-				DBLock.Unlock()
-				cluster.Clus.SendUI("DBSWITCHED", cmds[1])
-			case "DIE": // We're outta here!
-				if cmds[1] == cluster.HostName || cmds[1] == "all" {
-					Running = false
-					time.Sleep(time.Millisecond * 500) // Wait for everyone to get the message
-					cluster.Clus.Stop()
-					os.Exit(1)
-				}
 			}
+		case a := <-guardAborts:
+			abort(a.reason)
+			close(a.stopped)
+		case <-sched.startTimer:
+			sched.startTimer = nil
+			if !Running {
+				startRun()
+			}
+			reportSkew("start", sched.startAt)
+		case <-sched.stopTimer:
+			sched.stopTimer = nil
+			if Running {
+				stopRun()
+			}
+			reportSkew("stop", sched.stopAt)
 		case <-delay:
 			break
 		}
 	}
 }
+
+// abort stops the run, and any profile or search driving it, because
+// a guardrail gave way.
+func abort(reason string) {
+	StopProfile()
+	StopSearch()
+	if !Running {
+		return
+	}
+	noteAbort(reason)
+	stopRun()
+	cluster.Log("Aborted: %s", reason)
+	cluster.Clus.SendUI("ABORTED", reason)
+}
+
+// schedule is what STARTAT and STOPAT have armed.
+type schedule struct {
+	startAt, stopAt       time.Time
+	startTimer, stopTimer <-chan time.Time
+}
+
+// Tell the running RunLogs goroutines the active collections changed.
+func collsChanged() {
+	go func(p int32) {
+		for i := 0; i < int(p); i++ {
+			tickerChange <- true
+		}
+	}(numprocs)
+}
+
+// handle carries out an engine command. Messages are validated on
+// arrival, so payloads decode.
+func handle(msg *cluster.Message, sched *schedule) error {
+	switch msg.Type {
+	case "START":
+		if !Running {
+			startRun()
+		}
+	case "STOP":
+		if Running {
+			stopRun()
+		}
+	case "STARTAT", "STOPAT": // Every node, at a unix nanosecond instant
+		var ns int64
+		msg.Decode(&ns)
+		at := time.Unix(0, ns)
+		timer := time.After(at.Sub(time.Now()))
+		if msg.Type == "STARTAT" {
+			sched.startAt, sched.startTimer = at, timer
+		} else {
+			sched.stopAt, sched.stopTimer = at, timer
+		}
+		cluster.Clus.SendUI("ARMED", fmt.Sprintf("%s %d", strings.ToLower(msg.Type), ns))
+	case "DISARM":
+		sched.startTimer, sched.stopTimer = nil, nil
+		cluster.Clus.SendUI("ARMED", "disarmed")
+	case "ONCE": // For testing
+		if Running {
+			break
+		}
+		Running = true
+		MultiRunLogs()
+		Running = false
+		cluster.Clus.SendEngine("DONE")
+	case "PROCS":
+		msg.Decode(&cluster.PROCS)
+		if Running {
+			AdjustProcs()
+		} else {
+			cluster.Clus.SendUI("PROCSAT", cluster.PROCS)
+		}
+	case "EXIT":
+		close(cluster.Clus.UIMsgs) // Tell UI we're done.
+	case "TARGETQPS":
+		msg.Decode(&cluster.PERSEC)
+		if Running {
+			AdjustProcs()
+		}
+		cluster.Clus.SendUI("TARGETQPSAT", cluster.PERSEC)
+		if cluster.CLUSTERQPS != 0 { // Back to a per-node target
+			cluster.CLUSTERQPS = 0
+			cluster.Clus.SendUI("CLUSTERQPSAT", 0)
+		}
+	case "CLUSTERQPS": // The master splits it up with NODEQPS
+		var total int
+		msg.Decode(&total)
+		if n := cluster.Clus.Count(); total < n {
+			return fmt.Errorf("A cluster target of %d QPS is less than one for each of the %d nodes", total, n)
+		}
+		cluster.CLUSTERQPS = total
+		cluster.Clus.SendUI("CLUSTERQPSAT", cluster.CLUSTERQPS)
+		cluster.Clus.Rebalance()
+	case "NODEQPS":
+		var q cluster.NodeQPS
+		msg.Decode(&q)
+		if q.Share < 1 { // More nodes than QPS; a zero rate would stop the ticker.
+			q.Share = 1
+		}
+		cluster.PERSEC = q.Share
+		if Running {
+			AdjustProcs()
+		}
+		cluster.Clus.SendUI("TARGETQPSAT", cluster.PERSEC)
+		if q.Total != cluster.CLUSTERQPS {
+			cluster.CLUSTERQPS = q.Total
+			cluster.Clus.SendUI("CLUSTERQPSAT", q.Total)
+		}
+	case "COLLSTART", "COLLSTOP":
+		var letter string
+		msg.Decode(&letter)
+		if msg.Type == "COLLSTART" {
+			EnableColl(LetterToColl[letter])
+			cluster.Clus.SendUI("COLLSTARTED", letter)
+		} else {
+			DisableColl(LetterToColl[letter])
+			cluster.Clus.SendUI("COLLSTOPPED", letter)
+		}
+		collsChanged()
+	case "DB":
+		var name string
+		msg.Decode(&name)
+		if _, ok := Target(name); !ok {
+			return fmt.Errorf("No DB target %s", name)
+		}
+		WHICHDB = name
+		DBLock.Lock()
+		LiveDB = nil
+		DBLock.Unlock()
+		cluster.Clus.SendUI("DBSWITCHED", name)
+	case "DBTARGETS": // A newer set of DB targets
+		var set TargetSet
+		msg.Decode(&set)
+		adoptTargets(set)
+	case "BULK":
+		var args []string
+		msg.Decode(&args)
+		if err := SetBulk(args); err != nil {
+			return err
+		}
+		cluster.Clus.SendUI("BULKSET", BulkSettings())
+	case "GOSSIPKEY":
+		var change cluster.KeyChange
+		msg.Decode(&change)
+		if err := cluster.Clus.ChangeKey(change); err != nil {
+			return err
+		}
+		key, _ := cluster.ParseKey(change.Key)
+		cluster.Log("Gossip key %s: %s; keys now %s", change.Op, cluster.KeyID(key), strings.Join(cluster.Clus.Keys(), ", "))
+	case "VERIFYRESET": // A new verification baseline is being taken
+		ResetReplays()
+		sendReplays()
+	case "READS":
+		var args []string
+		msg.Decode(&args)
+		if err := SetReads(args); err != nil {
+			return err
+		}
+		cluster.Clus.SendUI("READSSET", ReadSettings())
+	case "GUARDRAILS":
+		var args []string
+		msg.Decode(&args)
+		if err := SetGuardrails(args); err != nil {
+			return err
+		}
+		cluster.Clus.SendUI("GUARDRAILSSET", GuardrailSettings())
+	case "ABORT": // A guardrail gave way, here or on another node
+		var reason string
+		msg.Decode(&reason)
+		abort(reason)
+	case "WRITECONCERN":
+		var spec string
+		msg.Decode(&spec)
+		if err := SetWriteConcern(spec); err != nil {
+			return err
+		}
+		cluster.Clus.SendUI("WRITECONCERNSET", spec)
+	case "DIE": // We're outta here!
+		Running = false
+		time.Sleep(time.Millisecond * 500) // Wait for everyone to get the message
+		cluster.Clus.Stop()
+		os.Exit(1)
+	}
+	return nil
+}
@@ -1,12 +1,17 @@
 package engine_test
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"gopkg.in/mgo.v2/bson"
 
@@ -16,6 +21,7 @@ import (
 	. "github.com/lyfe-mobile/hitter/cluster"
 	. "github.com/lyfe-mobile/hitter/common"
 	. "github.com/lyfe-mobile/hitter/engine"
+	"github.com/lyfe-mobile/hitter/stats"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -40,12 +46,413 @@ var _ = Describe("Engine", func() {
 	})
 	It("can connnect to Mongo", func() {
 		Ω(DialMongo()).Should(Succeed())
-		Ω(LiveDB.DB(MONGODB[WHICHDB]).CollectionNames()).Should(ContainElement("keymap2_rhythmx"))
+		Ω(LiveDB.DB(DBName()).CollectionNames()).Should(ContainElement("keymap2_rhythmx"))
 		LiveDB.Close()
 	})
 	It("will glob embedded filenames", func() {
 		Ω(Glob("logs/device_*13:06*")).Should(Equal([]string{"logs/device_data_static_2016-11-16T13:06:25Z"}))
 	})
+	Describe("Log directories", func() {
+		var logDir string
+		BeforeEach(func() {
+			logDir, _ = ioutil.TempDir(tempDir, "logs")
+			Ω(ioutil.WriteFile(filepath.Join(logDir, "advertiser_static_2017-01-01T00:00:00Z"),
+				[]byte("someone@example.com 30\n"), 0644)).Should(Succeed())
+			UseLogDir(logDir)
+		})
+		AfterEach(func() {
+			UseLogDir("")
+		})
+		It("globs files on disk", func() {
+			Ω(Glob("logs/advertiser_static_*")).Should(Equal([]string{
+				filepath.Join(logDir, "advertiser_static_2017-01-01T00:00:00Z"),
+			}))
+		})
+		It("falls back to embedded files", func() {
+			Ω(Glob("logs/device_*13:06*")).Should(Equal([]string{"logs/device_data_static_2016-11-16T13:06:25Z"}))
+		})
+		It("works out what replaying a log does", func() {
+			advLog := filepath.Join(logDir, "advertiser_static_2017-01-01T00:00:00Z")
+			Ω(LogIncrements(AdvertiserColl, advLog)).Should(Equal(Increments{
+				"someone@example.com": {"funds": -30},
+			}))
+			campLog := filepath.Join(logDir, "campaign_static_2017-01-01T00:00:00Z")
+			Ω(ioutil.WriteFile(campLog, []byte("58812f8bc3ee5a0fa7bd6a47 20\n58812f8bc3ee5a0fa7bd6a47 10\nnotacampaign 5\n"), 0644)).Should(Succeed())
+			Ω(LogIncrements(CampaignColl, campLog)).Should(Equal(Increments{
+				"58812f8bc3ee5a0fa7bd6a47": {"imps": 2, "spend": 30},
+			}))
+			tdLog := filepath.Join(logDir, "total_data_static_2017-01-01T00:00:00Z")
+			Ω(ioutil.WriteFile(tdLog, []byte("undecodable bid\n"), 0644)).Should(Succeed())
+			Ω(LogIncrements(TdColl, tdLog)).Should(BeEmpty()) // As TotalDataLog skips it
+			Ω(SumReplays(
+				map[string]ReplayCount{advLog: {Full: 2}},
+				map[string]ReplayCount{advLog: {Full: 1, Partial: 1}, campLog: {Partial: 1}},
+			)).Should(Equal(map[string]ReplayCount{advLog: {Full: 3, Partial: 1}, campLog: {Partial: 1}}))
+		})
+	})
+	It("generates synthetic logs that parse", func() {
+		cfg := DefaultSynthConfig()
+		cfg.Files = 2
+		cfg.Records = 50
+		src := NewSynthSource(cfg)
+		names, err := src.Glob("logs/total_data_static_*")
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(names).Should(HaveLen(2))
+		var first, again bytes.Buffer
+		Ω(src.Generate(&first, TdColl, names[0])).Should(Succeed())
+		Ω(src.Generate(&again, TdColl, names[0])).Should(Succeed())
+		Ω(first.String()).Should(Equal(again.String()))
+		lines := strings.Split(strings.TrimSpace(first.String()), "\n")
+		Ω(lines).Should(HaveLen(50))
+		for _, line := range lines {
+			event, err := UnpackEncodedID(strings.Fields(line)[0])
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(event.Advertiser).Should(MatchRegexp(`^synth\d+@example.com$`))
+		}
+	})
+	Describe("Load profiles", func() {
+		It("reads stages from JSON", func() {
+			p, err := ParseProfile([]byte(`{"Name": "test", "Stages": [
+				{"Kind": "ramp", "Duration": "1m", "From": 100, "To": 700},
+				{"Kind": "soak", "Duration": "90s", "From": 700, "Procs": 20}]}`))
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(p.Stages).Should(HaveLen(2))
+			Ω(p.Stages[1].Procs).Should(Equal(20))
+			Ω(p.Total()).Should(Equal(150 * time.Second))
+			_, err = ParseProfile([]byte(`{"Stages": [{"Kind": "wobble", "Duration": "1m"}]}`))
+			Ω(err).Should(HaveOccurred())
+			_, err = ParseProfile([]byte(`{"Stages": [{"Kind": "soak"}]}`))
+			Ω(err).Should(HaveOccurred())
+		})
+		It("shapes targets", func() {
+			minute := Duration{time.Minute}
+			ramp := Stage{Kind: "ramp", Duration: minute, From: 100, To: 700}
+			Ω(ramp.Target(0)).Should(Equal(100))
+			Ω(ramp.Target(30 * time.Second)).Should(Equal(400))
+			Ω(ramp.Target(2 * time.Minute)).Should(Equal(700))
+			step := Stage{Kind: "step", Duration: minute, From: 100, To: 400, Steps: 4}
+			Ω(step.Target(10 * time.Second)).Should(Equal(100))
+			Ω(step.Target(20 * time.Second)).Should(Equal(200))
+			Ω(step.Target(59 * time.Second)).Should(Equal(400))
+			spike := Stage{Kind: "spike", Duration: minute, From: 100, Peak: 1000, SpikeFor: Duration{5 * time.Second}}
+			Ω(spike.Target(29 * time.Second)).Should(Equal(100))
+			Ω(spike.Target(32 * time.Second)).Should(Equal(1000))
+			Ω(spike.Target(35 * time.Second)).Should(Equal(100))
+			sine := Stage{Kind: "sine", Duration: minute, From: 100, To: 300}
+			Ω(sine.Target(0)).Should(Equal(100))
+			Ω(sine.Target(30 * time.Second)).Should(Equal(300))
+			Ω(sine.Target(15 * time.Second)).Should(Equal(200))
+			soak := Stage{Kind: "soak", Duration: minute}
+			Ω(soak.Target(0)).Should(Equal(1)) // Never zero
+		})
+	})
+	It("sets the read workload", func() {
+		defer func() { READQPS, READRATIO = 0, 0 }()
+		Ω(SetReads([]string{"50"})).Should(Succeed())
+		Ω(ReadRate()).Should(Equal(50))
+		Ω(SetReads([]string{"50", "0.5"})).Should(Succeed())
+		Ω(ReadSettings()).Should(Equal("50 0.5"))
+		LastQPS = 300
+		Ω(ReadRate()).Should(Equal(150)) // The ratio wins
+		LastQPS = 0
+		Ω(SetReads([]string{"-1"})).ShouldNot(Succeed())
+		Ω(SetReads([]string{"5", "lots"})).ShouldNot(Succeed())
+
+		By("stopping the readers with their run")
+		READQPS, READRATIO = 2000000000, 0 // More than one a nanosecond
+		stop, done := make(chan bool), make(chan bool)
+		go func() {
+			RunReads(stop)
+			close(done)
+		}()
+		close(stop)
+		Eventually(done).Should(BeClosed())
+	})
+	It("validates messages", func() {
+		_, err := NewMessage("n", "", "PROCS", 3)
+		Ω(err).ShouldNot(HaveOccurred())
+		_, err = NewMessage("n", "", "PROCS", 0)
+		Ω(err).Should(MatchError("0 is less than 1"))
+		_, err = NewMessage("n", "", "PROCS")
+		Ω(err).Should(MatchError("PROCS needs a payload"))
+		_, err = NewMessage("n", "", "START")
+		Ω(err).Should(MatchError("START needs a target node"))
+		_, err = NewMessage("n", "c1", "START", "now")
+		Ω(err).Should(MatchError("START takes no payload"))
+		_, err = NewMessage("n", "c1", "COLLSTART", "X")
+		Ω(err).Should(MatchError(`Unknown collection "X"`))
+		_, err = NewMessage("n", "", "BULK", []string{"lots"})
+		Ω(err).Should(HaveOccurred())
+		_, err = NewMessage("n", "", "WRITECONCERN", "w2")
+		Ω(err).Should(HaveOccurred())
+		_, err = NewMessage("n", "", "FROB")
+		Ω(err).Should(MatchError(`Unknown message type "FROB"`))
+
+		msg := Message{Version: ProtocolVersion, Type: "TARGETQPS", Sender: "n", Payload: []byte(`"fast"`)}
+		Ω(msg.Validate()).Should(MatchError(ContainSubstring("Bad TARGETQPS payload")))
+		msg.Payload = []byte("500")
+		Ω(msg.Validate()).Should(Succeed())
+		msg.Version = ProtocolVersion + 1
+		Ω(msg.Validate()).ShouldNot(Succeed())
+
+		By("needing the right role to send them")
+		Ω(Allowed(Viewer, "TARGETQPS")).ShouldNot(Succeed())
+		Ω(Allowed(Operator, "STOP")).Should(Succeed())
+		Ω(Allowed(Operator, "DIE")).Should(MatchError("DIE needs the admin role"))
+		Ω(Allowed(Operator, "DB")).ShouldNot(Succeed())
+		Ω(Allowed(Admin, "DB")).Should(Succeed())
+		Ω(Allowed(Operator, "STARTED")).ShouldNot(Succeed())
+		for _, typ := range []string{"START", "STARTAT", "STOPAT", "DISARM", "TARGETQPS", "CLUSTERQPS",
+			"PROCS", "COLLSTART", "COLLSTOP", "BULK", "READS", "WRITECONCERN"} {
+			Ω(Allowed(Operator, typ)).Should(Succeed())
+		}
+		for _, typ := range []string{"DIE", "DB", "DBTARGETS", "GOSSIPKEY", "EXIT"} {
+			Ω(Allowed(Operator, typ)).Should(MatchError(typ + " needs the admin role"))
+			Ω(Allowed(Admin, typ)).Should(Succeed())
+		}
+	})
+	It("keeps run records", func() {
+		RunDir = filepath.Join(tempDir, "runs")
+		older := &RunRecord{ID: "older", Start: time.Unix(1000, 0), Series: []RunSecond{{QPS: 5}}}
+		newer := &RunRecord{ID: "newer", Start: time.Unix(2000, 0), Summary: RunSummary{PeakQPS: 7}}
+		Ω(SaveRun(older)).Should(Succeed())
+		Ω(SaveRun(newer)).Should(Succeed())
+		run, err := LoadRun("older")
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(run.Series).Should(Equal([]RunSecond{{QPS: 5}}))
+		runs, err := Runs()
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(runs).Should(HaveLen(2))
+		Ω(runs[0].ID).Should(Equal("newer"))
+		Ω(runs[0].Summary.PeakQPS).Should(BeNumerically("==", 7))
+		Ω(runs[1].Series).Should(BeNil()) // Just the summaries
+		Ω(ioutil.WriteFile(filepath.Join(RunDir, "broken.json"), []byte("{"), 0644)).Should(Succeed())
+		runs, err = Runs()
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(runs).Should(HaveLen(2))
+		_, err = LoadRun("../older")
+		Ω(err).Should(HaveOccurred())
+	})
+	It("checks headless runs against thresholds", func() {
+		report := &RunReport{MeanQPS: 950, Latency: stats.Percentiles{P99: 12.5}, ErrorRate: 0.002}
+		report.Check(Thresholds{MinQPS: 900, MaxP99: 20, MaxErrorRate: 0.01})
+		Ω(report.Passed).Should(BeTrue())
+		Ω(report.Failures).Should(BeEmpty())
+
+		report.Verify = &VerifyReport{Collections: map[string]*CollectionReport{
+			"advertiser": {Checked: 10, Matched: 8, Low: 2},
+			"campaign":   {Checked: 5, Matched: 5},
+		}}
+		report.Check(Thresholds{MinQPS: 1000, MaxP99: 10, MaxErrorRate: 0.001, Verify: true})
+		Ω(report.Passed).Should(BeFalse())
+		Ω(report.Failures).Should(Equal([]string{
+			"Mean QPS 950 is under 1000",
+			"p99 latency 12.5ms is over 10.0ms",
+			"Error rate 0.0020 is over 0.0010",
+			"advertiser: 2 of 10 documents don't match (0 missing, 2 low, 0 high)",
+		}))
+
+		report.Verify = nil
+		report.Check(Thresholds{Verify: true})
+		Ω(report.Failures).Should(Equal([]string{"Not verified"}))
+
+		report.Aborted = "c1: p99 latency 300.0ms over 200ms for 5s"
+		report.Check(Thresholds{})
+		Ω(report.Failures).Should(Equal([]string{"Aborted by c1: p99 latency 300.0ms over 200ms for 5s"}))
+	})
+	It("searches for the highest sustainable throughput", func() {
+		c, err := ParseSearchConfig([]byte(`{"Start": 500, "Step": "9s", "MaxP99": 50}`))
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(c.Factor).Should(Equal(2.0))
+		Ω(c.Settle.Duration).Should(Equal(3 * time.Second))
+		_, err = ParseSearchConfig([]byte(`{"Factor": 0.5}`))
+		Ω(err).Should(HaveOccurred())
+		_, err = ParseSearchConfig([]byte(`{"Step": "10s", "Settle": "10s"}`))
+		Ω(err).Should(HaveOccurred())
+
+		// A cluster that keeps up to 3000 QPS, but whose p99 passes 50ms
+		// past 2600.
+		s := NewSearch(*c)
+		var targets []int
+		for target := s.Next(); target > 0; target = s.Next() {
+			targets = append(targets, target)
+			step := SearchStep{Target: target, QPS: math.Min(float64(target), 3000), Latency: stats.Percentiles{P99: 10}}
+			if target > 2600 {
+				step.Latency.P99 = 80
+			}
+			s.Note(step)
+			Ω(len(targets)).Should(BeNumerically("<", 20))
+		}
+		Ω(targets).Should(Equal([]int{500, 1000, 2000, 4000, 3000, 2500, 2750, 2625}))
+		Ω(s.Steps[3].Why).Should(Equal("reached 3000 of 4000 QPS, p99 latency 80.0ms over 50ms"))
+		Ω(s.Steps[3].Phase).Should(Equal("ramp"))
+		Ω(s.Steps[4].Phase).Should(Equal("search"))
+		Ω(s.Best().Target).Should(Equal(2500)) // 2625 failed, and that's within 5%
+
+		capped := NewSearch(SearchConfig{Start: 100, Factor: 2, MaxQPS: 300, MinAchieved: 0.95, Precision: 0.05})
+		for _, want := range []int{100, 200, 300} {
+			Ω(capped.Next()).Should(Equal(want))
+			capped.Note(SearchStep{Target: want, QPS: float64(want)})
+		}
+		Ω(capped.Next()).Should(Equal(0))
+	})
+	It("judges search steps by what every node reports", func() {
+		// tell has node tell the UI typ, as the search takes it.
+		tell := func(node, typ string, payload interface{}) {
+			msg, err := NewMessage(node, "", typ, payload)
+			Ω(err).ShouldNot(HaveOccurred())
+			NoteSearchMessage(*msg)
+		}
+		hist := stats.NewHistogram()
+		for i := 1; i <= 100; i++ {
+			hist.Record(time.Duration(i) * time.Millisecond)
+		}
+		go func() {
+			time.Sleep(50 * time.Millisecond) // Settling
+			tell("mainproc", "ERRORS", stats.Counts{Attempted: 1000, Errors: map[string]uint64{"timeout": 10}})
+			tell("c1", "ERRORS", stats.Counts{Attempted: 500})
+			tell("c1", "QPS", [2]uint64{500, uint64(time.Now().Unix()) * 1000})
+			time.Sleep(450 * time.Millisecond)
+			next := uint64(time.Now().Unix()+1) * 1000
+			tell("mainproc", "QPS", [2]uint64{100, next})
+			tell("c1", "QPS", [2]uint64{50, next})
+			tell("c1", "QPS", [2]uint64{70, next - 60000}) // Long before the step
+			tell("c1", "LATENCY", LatencyReport{TS: next, Hist: hist})
+			tell("mainproc", "ERRORS", stats.Counts{Attempted: 1100, Errors: map[string]uint64{"timeout": 15}})
+			tell("c1", "ERRORS", stats.Counts{Attempted: 600, Errors: map[string]uint64{"timeout": 1}})
+		}()
+		step := MeasureSearchStep(SearchConfig{Step: Duration{3 * time.Second}, Settle: Duration{300 * time.Millisecond}}, 2)
+		Ω(step.Nodes).Should(Equal(2))
+		Ω(step.ClusterQPS).Should(BeNumerically("==", 150))
+		Ω(step.QPS).Should(BeNumerically("==", 75))
+		Ω(step.Latency.P99).Should(BeNumerically("~", hist.Percentiles().P99))
+		Ω(step.ErrorRate).Should(BeNumerically("~", 6.0/200))
+	})
+	It("trips guardrails", func() {
+		defer SetGuardrails(nil)
+		Ω(SetGuardrails([]string{"p99=200", "errorrate=0.01", "lag=10", "funds=100", "for=3"})).Should(Succeed())
+		Ω(GuardrailSettings()).Should(Equal("errorrate=0.01 p99=200 lag=10 funds=100 for=3"))
+		g := GUARDRAILS
+		Ω(SetGuardrails([]string{"p99"})).ShouldNot(Succeed())
+		Ω(SetGuardrails([]string{"speed=5"})).ShouldNot(Succeed())
+		Ω(SetGuardrails([]string{"lag=-1"})).ShouldNot(Succeed())
+		_, err := NewMessage("n", "", "GUARDRAILS", []string{"p99=fast"})
+		Ω(err).Should(HaveOccurred())
+
+		lag, funds := 12.0, 250.0
+		fine := GuardSample{P99: 150, Counts: stats.Counts{Attempted: 1000, Errors: map[string]uint64{"timeout": 5}}}
+		Ω(g.Breaches(fine)).Should(BeEmpty())
+		bad := GuardSample{P99: 250, Counts: stats.Counts{Attempted: 1000, Errors: map[string]uint64{"timeout": 50}},
+			Lag: &lag, Funds: &funds}
+		Ω(g.Breaches(bad)).Should(Equal(map[string]string{
+			"p99":       "p99 latency 250.0ms over 200ms",
+			"errorrate": "error rate 0.0500 over 0.01",
+			"lag":       "replication lag 12.0s over 10s",
+		}))
+
+		watch := NewGuardWatch()
+		slow := GuardSample{P99: 250}
+		Ω(watch.Note(g, g.Breaches(slow))).Should(BeEmpty())
+		Ω(watch.Note(g, g.Breaches(slow))).Should(BeEmpty())
+		Ω(watch.Note(g, g.Breaches(fine))).Should(BeEmpty()) // Back to the start
+		Ω(watch.Note(g, g.Breaches(slow))).Should(BeEmpty())
+		Ω(watch.Note(g, g.Breaches(slow))).Should(BeEmpty())
+		Ω(watch.Note(g, g.Breaches(slow))).Should(Equal("p99 latency 250.0ms over 200ms for 3s"))
+	})
+	Describe("Headless runs", func() {
+		var (
+			ts      *TestServer
+			restore func()
+			whichDB string
+			profile = &Profile{Name: "test"}
+		)
+		// from has node tell the UI typ, as the headless run takes it.
+		from := func(node, typ string, payload ...interface{}) {
+			msg, err := NewMessage(node, "", typ, payload...)
+			Ω(err).ShouldNot(HaveOccurred())
+			Clus.UIMsgs <- *msg
+		}
+		BeforeEach(func() {
+			ts = StartTestServer(tempDir)
+			restore = HeadlessWaits(100*time.Millisecond, 5*time.Second, 5*time.Second)
+			whichDB = WHICHDB
+		})
+		AfterEach(func() {
+			restore()
+			WHICHDB = whichDB
+			ts.Stop()
+		})
+		It("adds up what every node reports over the run", func() {
+			from("mainproc", "ERRORS", stats.Counts{Attempted: 1000, Succeeded: 990, Failed: 10,
+				Errors: map[string]uint64{"timeout": 10}}) // Before it
+			from("c1", "ERRORS", stats.Counts{Attempted: 500, Succeeded: 500})
+			hist := stats.NewHistogram()
+			for i := 1; i <= 100; i++ {
+				hist.Record(time.Duration(i) * time.Millisecond)
+			}
+			var replaysSent time.Time
+			monkey.Patch(StartProfile, func(*Profile) error {
+				go func() {
+					now := uint64(time.Now().Unix()) * 1000
+					from("mainproc", "QPS", [2]uint64{100, now})
+					from("c1", "QPS", [2]uint64{50, now})
+					from("c1", "QPS", [2]uint64{70, now - 60000}) // Long before the run
+					from("c1", "LATENCY", LatencyReport{TS: now, Hist: hist})
+					from("mainproc", "ERRORS", stats.Counts{Attempted: 1100, Succeeded: 1085, Failed: 15,
+						Errors: map[string]uint64{"timeout": 15}})
+					from("c1", "ERRORS", stats.Counts{Attempted: 700, Succeeded: 695, Failed: 5,
+						Errors: map[string]uint64{"timeout": 5}})
+					time.Sleep(1500 * time.Millisecond) // Past the wait for the run ID
+					from("mainproc", "PROFILEDONE", ProfileProgress{Name: "test", Done: true})
+					time.Sleep(time.Second)
+					replaysSent = time.Now()
+					from("mainproc", "REPLAYS", map[string]ReplayCount{})
+				}()
+				return nil
+			})
+			report, err := RunHeadless(profile, "", Thresholds{MaxErrorRate: 0.01}, ioutil.Discard)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(replaysSent.IsZero()).Should(BeFalse())                          // It waited for them,
+			Ω(time.Since(replaysSent)).Should(BeNumerically("<", time.Second)) // and not for long after
+			Ω(report.Nodes).Should(Equal([]string{"mainproc"}))
+			Ω(report.Series).Should(HaveLen(1))
+			Ω(report.Series[0].QPS).Should(BeNumerically("==", 150))
+			Ω(report.Series[0].Nodes).Should(Equal(2))
+			Ω(report.Series[0].P99).Should(BeNumerically("~", hist.Percentiles().P99))
+			Ω(report.Operations).Should(BeNumerically("==", 150))
+			Ω(report.PeakQPS).Should(BeNumerically("==", 150))
+			Ω(report.Counts.Attempted).Should(BeNumerically("==", 300))
+			Ω(report.Counts.Failed).Should(BeNumerically("==", 10))
+			Ω(report.Counts.Errors).Should(Equal(map[string]uint64{"timeout": 10}))
+			Ω(report.ErrorRate).Should(BeNumerically("~", 10.0/300))
+			Ω(report.Failures).Should(Equal([]string{"Error rate 0.0333 is over 0.0100"}))
+		})
+		It("switches every node to the DB target first", func() {
+			go Engine()
+			defer func() {
+				Clus.SendEngine("EXIT")
+				Eventually(Clus.UIMsgs).Should(BeClosed())
+			}()
+			monkey.Patch(StartProfile, func(*Profile) error {
+				go from("mainproc", "PROFILEDONE", ProfileProgress{Name: "test", Done: true})
+				go from("mainproc", "REPLAYS", map[string]ReplayCount{})
+				return nil
+			})
+			report, err := RunHeadless(profile, "local", Thresholds{}, ioutil.Discard)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(report.Target).Should(Equal("local"))
+			Ω(WHICHDB).Should(Equal("local"))
+		})
+		It("fails when a node won't switch", func() {
+			go Engine()
+			defer func() {
+				Clus.SendEngine("EXIT")
+				Eventually(Clus.UIMsgs).Should(BeClosed())
+			}()
+			_, err := RunHeadless(profile, "nowhere", Thresholds{}, ioutil.Discard)
+			Ω(err).Should(MatchError("Switching to DB target nowhere: mainproc: No DB target nowhere"))
+		})
+	})
 	Describe("Data loads", func() {
 		var (
 			m  *Cluster
@@ -62,9 +469,9 @@ var _ = Describe("Engine", func() {
 		})
 		It("loads data locally", func() {
 			m.SendEngine("ONCE")
-			Eventually(m.EngineMsgs, 3).Should(Receive(Equal([]byte("DONE"))))
+			Eventually(m.EngineMsgs, 3).Should(Receive(Says("^DONE ")))
 
-			tdColl := ts.DbSession.DB(MONGODB[WHICHDB]).C("total_data")
+			tdColl := ts.DbSession.DB(DBName()).C("total_data")
 			query := tdColl.Find(bson.M{})
 			Ω(tdColl.Count()).Should(BeNumerically("==", 109))
 			var results []interface{}
@@ -77,13 +484,13 @@ var _ = Describe("Engine", func() {
 			}
 			Ω(won).Should(BeNumerically("==", 469))
 
-			devColl := ts.DbSession.DB(MONGODB[WHICHDB]).C("device_data")
+			devColl := ts.DbSession.DB(DBName()).C("device_data")
 			Ω(devColl.Count()).Should(BeNumerically("==", 398))
 
-			locColl := ts.DbSession.DB(MONGODB[WHICHDB]).C("location_data")
+			locColl := ts.DbSession.DB(DBName()).C("location_data")
 			Ω(locColl.Count()).Should(BeNumerically("==", 979))
 
-			advColl := ts.DbSession.DB(MONGODB[WHICHDB]).C("advertiser")
+			advColl := ts.DbSession.DB(DBName()).C("advertiser")
 			query = advColl.Find(bson.M{})
 			Ω(query.Iter().All(&results)).Should(Succeed())
 			for _, inter := range results {
@@ -91,7 +498,7 @@ var _ = Describe("Engine", func() {
 				Ω(result["funds"]).Should(BeNumerically("==", 1))
 			}
 
-			campColl := ts.DbSession.DB(MONGODB[WHICHDB]).C("campaign")
+			campColl := ts.DbSession.DB(DBName()).C("campaign")
 			query = campColl.Find(bson.M{})
 			Ω(query.Iter().All(&results)).Should(Succeed())
 			for _, inter := range results {
@@ -99,14 +506,26 @@ var _ = Describe("Engine", func() {
 				Ω(result["imps"]).Should(BeNumerically(">=", 1))
 			}
 			ts.Ft.Tick() // Get qpsmonitor going.
-			Eventually(m.UIMsgs).Should(Receive(Equal([]uint8("QPS c1 1500"))))
+			Eventually(m.UIMsgs).Should(Receive(Says(`^QPS c1 \[1500,`)))
+		})
+		It("loads data in bulk", func() {
+			Ω(SetBulk([]string{"25", "unordered", "batch"})).Should(Succeed())
+			defer SetBulk([]string{"0", "ordered", "doc"})
+			Ω(BulkSettings()).Should(Equal("25 unordered batch"))
+			m.SendEngine("ONCE")
+			Eventually(m.EngineMsgs, 3).Should(Receive(Says("^DONE ")))
+
+			tdColl := ts.DbSession.DB(DBName()).C("total_data")
+			Ω(tdColl.Count()).Should(BeNumerically("==", 109))
+			devColl := ts.DbSession.DB(DBName()).C("device_data")
+			Ω(devColl.Count()).Should(BeNumerically("==", 398))
 		})
 		It("can load with multiple procs", func() {
-			m.SendEngine("PROCS 3")
+			m.SendEngine("PROCS", 3)
 			m.SendEngine("ONCE")
-			Eventually(m.EngineMsgs, 3).Should(Receive(Equal([]byte("DONE"))))
+			Eventually(m.EngineMsgs, 3).Should(Receive(Says("^DONE ")))
 
-			tdColl := ts.DbSession.DB(MONGODB[WHICHDB]).C("total_data")
+			tdColl := ts.DbSession.DB(DBName()).C("total_data")
 			query := tdColl.Find(bson.M{})
 			Ω(tdColl.Count()).Should(BeNumerically("==", 109))
 			var results []interface{}
@@ -123,8 +542,8 @@ var _ = Describe("Engine", func() {
 				return nil, fmt.Errorf("Fake Globbing Error")
 			})
 			m.SendEngine("ONCE")
-			Eventually(m.EngineMsgs).Should(Receive(Equal([]byte("DONE"))))
-			Eventually(m.UIMsgs).Should(Receive(MatchRegexp("LOG c1 .* Globbing files: Fake Globbing Error")))
+			Eventually(m.EngineMsgs).Should(Receive(Says("^DONE ")))
+			Eventually(m.UIMsgs).Should(Receive(Says(`^LOG c1 ".* Globbing files: Fake Globbing Error"`)))
 		})
 		It("UI receives logs", func() {
 			m.SendUI("LOG", "Some Log Message")
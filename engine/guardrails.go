@@ -0,0 +1,279 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	. "github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/stats"
+)
+
+// Guardrails stop a run before it harms the database it's hitting.
+// Each node checks them every second while it runs, and when one has
+// been breached for For seconds in a row it stops every node with an
+// ABORT. Zero values aren't checked.
+type Guardrails struct {
+	ErrorRate float64 // Errors per attempted operation, on this node
+	P99       float64 // Milliseconds, on this node
+	Lag       float64 // Seconds the furthest behind secondary may trail the primary
+	Funds     float64 // Least any advertiser may have left
+	For       int     // Seconds; at least 1
+}
+
+var (
+	GUARDRAILS     Guardrails
+	guardrailMutex sync.Mutex
+)
+
+// ParseGuardrails reads key=value arguments, as GUARDRAILS takes:
+// errorrate, p99, lag, funds and for.
+func ParseGuardrails(args []string) (g Guardrails, err error) {
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			return g, fmt.Errorf("Guardrails are key=value, not %q", arg)
+		}
+		if parts[0] == "for" {
+			if g.For, err = strconv.Atoi(parts[1]); err != nil || g.For < 0 {
+				return g, fmt.Errorf("Bad guardrail seconds %q", parts[1])
+			}
+			continue
+		}
+		n, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || n < 0 {
+			return g, fmt.Errorf("Bad guardrail %s %q", parts[0], parts[1])
+		}
+		switch parts[0] {
+		case "errorrate":
+			g.ErrorRate = n
+		case "p99":
+			g.P99 = n
+		case "lag":
+			g.Lag = n
+		case "funds":
+			g.Funds = n
+		default:
+			return g, fmt.Errorf("Unknown guardrail %q", parts[0])
+		}
+	}
+	return g, nil
+}
+
+// SetGuardrails takes the arguments of a GUARDRAILS command.
+func SetGuardrails(args []string) error {
+	g, err := ParseGuardrails(args)
+	if err != nil {
+		return err
+	}
+	guardrailMutex.Lock()
+	GUARDRAILS = g
+	guardrailMutex.Unlock()
+	return nil
+}
+
+// GuardrailSettings is the GUARDRAILS arguments for the current
+// settings, empty if there are none.
+func GuardrailSettings() string {
+	guardrailMutex.Lock()
+	g := GUARDRAILS
+	guardrailMutex.Unlock()
+	var args []string
+	for _, setting := range []struct {
+		name string
+		n    float64
+	}{{"errorrate", g.ErrorRate}, {"p99", g.P99}, {"lag", g.Lag}, {"funds", g.Funds}, {"for", float64(g.For)}} {
+		if setting.n > 0 {
+			args = append(args, fmt.Sprintf("%s=%g", setting.name, setting.n))
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// GuardSample is a second of what the guardrails watch. Lag and Funds
+// are nil when they weren't looked up.
+type GuardSample struct {
+	P99    float64
+	Counts stats.Counts // Just this second's
+	Lag    *float64     // Seconds
+	Funds  *float64     // The poorest advertiser's
+}
+
+// Breaches gives how s breaks each guardrail it breaks.
+func (g Guardrails) Breaches(s GuardSample) map[string]string {
+	breaches := map[string]string{}
+	if g.ErrorRate > 0 && s.Counts.Attempted > 0 {
+		if rate := float64(s.Counts.TotalErrors()) / float64(s.Counts.Attempted); rate > g.ErrorRate {
+			breaches["errorrate"] = fmt.Sprintf("error rate %.4f over %g", rate, g.ErrorRate)
+		}
+	}
+	if g.P99 > 0 && s.P99 > g.P99 {
+		breaches["p99"] = fmt.Sprintf("p99 latency %.1fms over %gms", s.P99, g.P99)
+	}
+	if g.Lag > 0 && s.Lag != nil && *s.Lag > g.Lag {
+		breaches["lag"] = fmt.Sprintf("replication lag %.1fs over %gs", *s.Lag, g.Lag)
+	}
+	if g.Funds > 0 && s.Funds != nil && *s.Funds < g.Funds {
+		breaches["funds"] = fmt.Sprintf("advertiser funds %g under %g", *s.Funds, g.Funds)
+	}
+	return breaches
+}
+
+// GuardWatch counts how many seconds in a row each guardrail has been
+// breached.
+type GuardWatch struct {
+	seconds map[string]int
+}
+
+func NewGuardWatch() *GuardWatch {
+	return &GuardWatch{seconds: map[string]int{}}
+}
+
+// Note takes a second's breaches, giving why to stop if one has gone
+// on for g.For seconds.
+func (w *GuardWatch) Note(g Guardrails, breaches map[string]string) string {
+	need := g.For
+	if need < 1 {
+		need = 1
+	}
+	var reasons []string
+	for name := range w.seconds {
+		if _, ok := breaches[name]; !ok {
+			delete(w.seconds, name)
+		}
+	}
+	for name, breach := range breaches {
+		if w.seconds[name]++; w.seconds[name] >= need {
+			reasons = append(reasons, fmt.Sprintf("%s for %ds", breach, w.seconds[name]))
+		}
+	}
+	if len(reasons) == 0 {
+		return ""
+	}
+	sort.Strings(reasons)
+	w.seconds = map[string]int{}
+	return strings.Join(reasons, ", ")
+}
+
+type guardSecond struct {
+	p99    float64
+	counts stats.Counts // Running totals
+}
+
+// MonitorQPS's seconds for watchGuardrails, which skips any that come
+// while it's still looking at the last.
+var guardSeconds = make(chan guardSecond, 1)
+
+func noteGuardSecond(p99 float64, counts stats.Counts) {
+	select {
+	case guardSeconds <- guardSecond{p99, counts}:
+	default:
+	}
+}
+
+// A guardrail this node breached, for the engine to abort its own run
+// on, ahead of whatever messages it has queued. It closes stopped
+// once it has.
+type guardAbort struct {
+	reason  string
+	stopped chan bool
+}
+
+var guardAborts = make(chan guardAbort)
+
+// watchGuardrails checks the guardrails each second this node runs,
+// aborting the run here and then on every other node when one gives
+// way.
+func watchGuardrails() {
+	watch := NewGuardWatch()
+	var last stats.Counts
+	lookupFailed := map[string]bool{} // Logged once each
+	for second := range guardSeconds {
+		recent := second.counts.Sub(last)
+		last = second.counts
+		guardrailMutex.Lock()
+		g := GUARDRAILS
+		guardrailMutex.Unlock()
+		if !Running || g == (Guardrails{}) {
+			watch = NewGuardWatch()
+			continue
+		}
+		sample := GuardSample{P99: second.p99, Counts: recent}
+		if g.Lag > 0 || g.Funds > 0 {
+			if session := readSession(); session != nil {
+				if g.Lag > 0 {
+					lag, err := replicationLag(session)
+					if err == nil {
+						sample.Lag = &lag
+					} else if !lookupFailed["lag"] {
+						lookupFailed["lag"] = true
+						cluster.Log("Can't check replication lag: %s", err)
+					}
+				}
+				if g.Funds > 0 {
+					funds, err := leastFunds(session.DB(DBName()))
+					if err == nil {
+						sample.Funds = &funds
+					} else if !lookupFailed["funds"] {
+						lookupFailed["funds"] = true
+						cluster.Log("Can't check advertiser funds: %s", err)
+					}
+				}
+				session.Close()
+			}
+		}
+		if reason := watch.Note(g, g.Breaches(sample)); reason != "" {
+			a := guardAbort{fmt.Sprintf("%s: %s", cluster.HostName, reason), make(chan bool)}
+			guardAborts <- a
+			<-a.stopped
+			cluster.Clus.SendEngineTo("*", "ABORT", a.reason)
+		}
+	}
+}
+
+// replicationLag is how many seconds the furthest behind secondary
+// trails the primary. It's 0 without any secondaries.
+func replicationLag(session *mgo.Session) (float64, error) {
+	var status struct {
+		Members []struct {
+			State  string    `bson:"stateStr"`
+			Optime time.Time `bson:"optimeDate"`
+		} `bson:"members"`
+	}
+	if err := session.Run(bson.D{{Name: "replSetGetStatus", Value: 1}}, &status); err != nil {
+		return 0, err
+	}
+	var primary, behind time.Time
+	for _, member := range status.Members {
+		switch member.State {
+		case "PRIMARY":
+			primary = member.Optime
+		case "SECONDARY":
+			if behind.IsZero() || member.Optime.Before(behind) {
+				behind = member.Optime
+			}
+		}
+	}
+	if primary.IsZero() || behind.IsZero() {
+		return 0, nil
+	}
+	return primary.Sub(behind).Seconds(), nil
+}
+
+// leastFunds is what the poorest advertiser has left.
+func leastFunds(db *mgo.Database) (float64, error) {
+	var result bson.M
+	err := db.C(AdvertiserColl).Find(bson.M{"funds": bson.M{"$exists": true}}).
+		Sort("funds").Select(bson.M{"funds": 1}).One(&result)
+	if err != nil {
+		return 0, err
+	}
+	return number(result["funds"]), nil
+}
@@ -0,0 +1,332 @@
+package engine
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bradfitz/slice"
+	"github.com/lyfe-mobile/hitter/cluster"
+	. "github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/stats"
+)
+
+// Thresholds are what a headless run has to meet to pass. Zero values
+// aren't checked.
+type Thresholds struct {
+	MinQPS       float64 `json:"minqps"`       // Mean across the cluster over the run
+	MaxP99       float64 `json:"maxp99"`       // Milliseconds, across the cluster
+	MaxErrorRate float64 `json:"maxerrorrate"` // Errors per attempted operation
+	Verify       bool    `json:"verify"`       // Every document matches the replays
+}
+
+// ReportSecond is one second of a headless run, across the cluster.
+type ReportSecond struct {
+	TS    uint64  `json:"ts"` // Unix milliseconds
+	QPS   uint64  `json:"qps"`
+	P99   float64 `json:"p99"`
+	Nodes int     `json:"nodes"` // That reported this second
+}
+
+// RunReport is how a headless run went across the whole cluster.
+type RunReport struct {
+	Profile    string            `json:"profile"`
+	Target     string            `json:"target"` // The DB target
+	Nodes      []string          `json:"nodes"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	Series     []ReportSecond    `json:"series"`
+	Operations uint64            `json:"operations"`
+	MeanQPS    float64           `json:"meanqps"`
+	PeakQPS    uint64            `json:"peakqps"`
+	Latency    stats.Percentiles `json:"latency"`
+	Counts     stats.Counts      `json:"counts"` // Just this run's
+	ErrorRate  float64           `json:"errorrate"`
+	Verify     *VerifyReport     `json:"verify,omitempty"`
+	Run        string            `json:"run,omitempty"`     // This node's run record
+	Aborted    string            `json:"aborted,omitempty"` // Why a guardrail stopped it
+
+	Thresholds Thresholds `json:"thresholds"`
+	Failures   []string   `json:"failures"`
+	Passed     bool       `json:"passed"`
+}
+
+// Check judges the run against t, filling in Failures and Passed.
+func (r *RunReport) Check(t Thresholds) {
+	r.Thresholds = t
+	r.Failures = []string{}
+	fail := func(format string, args ...interface{}) {
+		r.Failures = append(r.Failures, fmt.Sprintf(format, args...))
+	}
+	if r.Aborted != "" {
+		fail("Aborted by %s", r.Aborted)
+	}
+	if t.MinQPS > 0 && r.MeanQPS < t.MinQPS {
+		fail("Mean QPS %.0f is under %.0f", r.MeanQPS, t.MinQPS)
+	}
+	if t.MaxP99 > 0 && r.Latency.P99 > t.MaxP99 {
+		fail("p99 latency %.1fms is over %.1fms", r.Latency.P99, t.MaxP99)
+	}
+	if t.MaxErrorRate > 0 && r.ErrorRate > t.MaxErrorRate {
+		fail("Error rate %.4f is over %.4f", r.ErrorRate, t.MaxErrorRate)
+	}
+	if t.Verify {
+		switch {
+		case r.Verify == nil:
+			fail("Not verified")
+		case !r.Verify.OK():
+			for _, coll := range LogOrder {
+				if cr, ok := r.Verify.Collections[coll]; ok && cr.Matched != cr.Checked {
+					fail("%s: %d of %d documents don't match (%d missing, %d low, %d high)",
+						coll, cr.Checked-cr.Matched, cr.Checked, cr.Missing, cr.Low, cr.High)
+				}
+			}
+		}
+	}
+	r.Passed = len(r.Failures) == 0
+}
+
+// How long a headless run waits before starting, for nodes to join
+// and report, at most for them all to switch DB target, and at most
+// after it for their last replay counts.
+var (
+	headlessSettle  = 2 * time.Second
+	headlessSwitch  = 10 * time.Second
+	headlessReplays = 10 * time.Second
+)
+
+// runWatch follows the cluster through the messages meant for the UI,
+// which a headless run has in its place.
+type runWatch struct {
+	mutex      sync.Mutex
+	out        io.Writer
+	start, end uint64 // Unix milliseconds of the run; end 0 while it's going
+	qps        map[uint64]uint64
+	nodes      map[uint64]int
+	hists      map[uint64]*stats.Histogram
+	total      *stats.Histogram
+	before     map[string]stats.Counts // Each node's running totals as the run started
+	last       map[string]stats.Counts
+	replays    map[string]map[string]ReplayCount
+	switched   map[string]string // Each node's DB target, once it says it switched
+	dbErrors   []string          // Nodes that wouldn't switch, and why
+	aborted    string            // The first guardrail to give way
+	done       chan bool
+}
+
+func newRunWatch(out io.Writer) *runWatch {
+	return &runWatch{
+		out:      out,
+		qps:      map[uint64]uint64{},
+		nodes:    map[uint64]int{},
+		hists:    map[uint64]*stats.Histogram{},
+		total:    stats.NewHistogram(),
+		before:   map[string]stats.Counts{},
+		last:     map[string]stats.Counts{},
+		replays:  map[string]map[string]ReplayCount{},
+		switched: map[string]string{},
+		done:     make(chan bool),
+	}
+}
+
+func (w *runWatch) during(ts uint64) bool {
+	return w.start != 0 && ts >= w.start && (w.end == 0 || ts <= w.end)
+}
+
+func (w *runWatch) follow(msgs <-chan cluster.Message) {
+	finished := false
+	for msg := range msgs {
+		if !msg.For(cluster.Clus.Name, cluster.Clus.Labels) {
+			continue
+		}
+		node := msg.Sender
+		w.mutex.Lock()
+		switch msg.Type {
+		case "LOG":
+			var line string
+			msg.Decode(&line)
+			fmt.Fprintf(w.out, "%s %s: %s\n", time.Now().Format("15:04:05"), node, line)
+		case "ERROR":
+			var reply cluster.ErrorReply
+			msg.Decode(&reply)
+			fmt.Fprintf(w.out, "%s %s: %s refused: %s\n", time.Now().Format("15:04:05"), node, reply.For, reply.Error)
+			if reply.For == "DB" {
+				w.dbErrors = append(w.dbErrors, fmt.Sprintf("%s: %s", node, reply.Error))
+			}
+		case "DBSWITCHED":
+			var name string
+			msg.Decode(&name)
+			w.switched[node] = name
+		case "PROFILESTAGE":
+			var progress ProfileProgress
+			msg.Decode(&progress)
+			fmt.Fprintf(w.out, "%s Stage %d of %d (%s), %d QPS a node\n",
+				time.Now().Format("15:04:05"), progress.Stage, progress.Stages, progress.Kind, progress.Target)
+		case "PROFILEDONE":
+			if node == cluster.Clus.Name && !finished {
+				finished = true
+				close(w.done)
+			}
+		case "QPS":
+			var qps [2]uint64 // QPS, date
+			msg.Decode(&qps)
+			if w.during(qps[1]) {
+				w.qps[qps[1]] += qps[0]
+				w.nodes[qps[1]]++
+			}
+		case "LATENCY":
+			var report LatencyReport
+			msg.Decode(&report)
+			if report.Hist != nil && w.during(report.TS) {
+				if w.hists[report.TS] == nil {
+					w.hists[report.TS] = stats.NewHistogram()
+				}
+				w.hists[report.TS].Merge(report.Hist)
+				w.total.Merge(report.Hist)
+			}
+		case "ERRORS":
+			var counts stats.Counts
+			msg.Decode(&counts)
+			if _, ok := w.before[node]; !ok || w.start == 0 {
+				w.before[node] = counts
+			}
+			w.last[node] = counts
+		case "REPLAYS":
+			var counts map[string]ReplayCount
+			msg.Decode(&counts)
+			w.replays[node] = counts
+		case "ABORTED":
+			var reason string
+			msg.Decode(&reason)
+			if w.aborted == "" {
+				w.aborted = reason
+			}
+		}
+		w.mutex.Unlock()
+	}
+}
+
+// switchDB has every node switch to the DB target name, and waits
+// until each says it has.
+func (w *runWatch) switchDB(name string) error {
+	w.mutex.Lock()
+	w.switched, w.dbErrors = map[string]string{}, nil
+	w.mutex.Unlock()
+	if err := cluster.Clus.SendEngine("DB", name); err != nil {
+		return err
+	}
+	for deadline := time.Now().Add(headlessSwitch); ; time.Sleep(100 * time.Millisecond) {
+		var waiting []string
+		w.mutex.Lock()
+		for _, member := range cluster.Clus.Members.Members() {
+			if w.switched[member.Name] != name {
+				waiting = append(waiting, member.Name)
+			}
+		}
+		refused := w.dbErrors
+		w.mutex.Unlock()
+		switch {
+		case len(refused) > 0:
+			return fmt.Errorf("Switching to DB target %s: %s", name, strings.Join(refused, "; "))
+		case len(waiting) == 0:
+			return nil
+		case time.Now().After(deadline):
+			slice.Sort(waiting, func(i, j int) bool { return waiting[i] < waiting[j] })
+			return fmt.Errorf("%s didn't switch to DB target %s", strings.Join(waiting, ", "), name)
+		}
+	}
+}
+
+// RunHeadless runs p across the cluster with no UI, first switching
+// every node to the DB target called target unless that's "". It takes
+// the UI's messages for itself, writing the nodes' logs to out as it
+// goes, then reports how the run went against t.
+func RunHeadless(p *Profile, target string, t Thresholds, out io.Writer) (*RunReport, error) {
+	w := newRunWatch(out)
+	go w.follow(cluster.Clus.UIMsgs)
+	time.Sleep(headlessSettle)
+	if target != "" {
+		if err := w.switchDB(target); err != nil {
+			return nil, err
+		}
+	}
+
+	var baseline *Baseline
+	if t.Verify {
+		cluster.Clus.SendEngine("VERIFYRESET")
+		var err error
+		if baseline, err = TakeBaseline(); err != nil {
+			return nil, err
+		}
+	}
+	report := &RunReport{Profile: p.Name, Target: WHICHDB, Start: time.Now()}
+	w.mutex.Lock()
+	w.start = uint64(report.Start.Unix()) * 1000
+	w.mutex.Unlock()
+	if err := StartProfile(p); err != nil {
+		return nil, err
+	}
+	time.Sleep(time.Second) // For the engine to start recording
+	report.Run = currentRunID()
+	<-w.done
+	report.End = time.Now()
+
+	// Replay counts come a little after each node stops, once it's done.
+	w.mutex.Lock()
+	w.end = uint64(report.End.Unix()) * 1000
+	w.replays = map[string]map[string]ReplayCount{}
+	w.mutex.Unlock()
+	for deadline := time.Now().Add(headlessReplays); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		w.mutex.Lock()
+		replayed := len(w.replays)
+		w.mutex.Unlock()
+		if replayed >= cluster.Clus.Count() {
+			break
+		}
+	}
+
+	w.mutex.Lock()
+	for _, member := range cluster.Clus.Members.Members() {
+		report.Nodes = append(report.Nodes, member.Name)
+	}
+	slice.Sort(report.Nodes, func(i, j int) bool { return report.Nodes[i] < report.Nodes[j] })
+	for ts, qps := range w.qps {
+		second := ReportSecond{TS: ts, QPS: qps, Nodes: w.nodes[ts]}
+		if hist := w.hists[ts]; hist != nil {
+			second.P99 = hist.Percentiles().P99
+		}
+		report.Series = append(report.Series, second)
+		report.Operations += qps
+		if qps > report.PeakQPS {
+			report.PeakQPS = qps
+		}
+	}
+	slice.Sort(report.Series, func(i, j int) bool { return report.Series[i].TS < report.Series[j].TS })
+	if seconds := report.End.Sub(report.Start).Seconds(); seconds > 0 {
+		report.MeanQPS = float64(report.Operations) / seconds
+	}
+	report.Latency = w.total.Percentiles()
+	report.Aborted = w.aborted
+	for node, counts := range w.last {
+		report.Counts.Add(counts.Sub(w.before[node]))
+	}
+	if report.Counts.Attempted > 0 {
+		report.ErrorRate = float64(report.Counts.TotalErrors()) / float64(report.Counts.Attempted)
+	}
+	var counts []map[string]ReplayCount
+	for _, node := range report.Nodes {
+		counts = append(counts, w.replays[node])
+	}
+	w.mutex.Unlock()
+
+	if t.Verify {
+		var err error
+		if report.Verify, err = Verify(baseline, SumReplays(counts...)); err != nil {
+			return nil, err
+		}
+	}
+	report.Check(t)
+	return report, nil
+}
@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/lyfe-mobile/hitter/data"
+)
+
+// LogSource is somewhere RunLogs can find and read replay logs
+// from. Patterns and names look like "logs/<coll>_static_*", the same
+// as the embedded assets.
+type LogSource interface {
+	Glob(pattern string) ([]string, error)
+	Open(name string) (io.ReadCloser, error)
+}
+
+// Source is where logs are currently replayed from.
+var Source LogSource = AssetSource{}
+
+// AssetSource reads the logs compiled in with go-bindata.
+type AssetSource struct{}
+
+func (a AssetSource) Glob(pattern string) (matches []string, err error) {
+	names := data.AssetNames()
+	sort.Strings(names)
+	var matched bool
+	dir, file := filepath.Split(pattern)
+
+	for _, n := range names {
+		if len(n) < len(dir) || n[:len(dir)] != dir {
+			continue
+		}
+		n = n[len(dir):]
+		matched, err = filepath.Match(file, n)
+		if err != nil {
+			return
+		}
+		if matched {
+			matches = append(matches, filepath.Join(dir, n))
+		}
+	}
+	return
+}
+
+func (a AssetSource) Open(name string) (io.ReadCloser, error) {
+	b, err := data.Asset(name)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (a AssetSource) String() string {
+	return "embedded"
+}
+
+// DirSource reads logs from a directory on disk. Only the file part
+// of a pattern is used, so "logs/total_data_static_*" matches
+// "<Dir>/total_data_static_*". Files are streamed, not slurped.
+type DirSource struct {
+	Dir string
+}
+
+func (d DirSource) Glob(pattern string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(d.Dir, filepath.Base(pattern)))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+func (d DirSource) Open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+func (d DirSource) String() string {
+	return "dir:" + d.Dir
+}
+
+// FallbackSource uses the first source that has any logs matching a
+// pattern. Typically a DirSource backed by the embedded AssetSource.
+type FallbackSource []LogSource
+
+func (f FallbackSource) Glob(pattern string) ([]string, error) {
+	for _, src := range f {
+		matches, err := src.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) > 0 {
+			return matches, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f FallbackSource) Open(name string) (io.ReadCloser, error) {
+	var err error
+	for _, src := range f {
+		var r io.ReadCloser
+		if r, err = src.Open(name); err == nil {
+			return r, nil
+		}
+	}
+	if err == nil {
+		err = fmt.Errorf("No log sources to open %s", name)
+	}
+	return nil, err
+}
+
+func (f FallbackSource) String() string {
+	var s string
+	for i, src := range f {
+		if i > 0 {
+			s += ","
+		}
+		s += fmt.Sprint(src)
+	}
+	return s
+}
+
+// UseLogDir replays logs from dir when it has any, falling back to
+// the embedded ones otherwise. An empty dir means embedded only.
+func UseLogDir(dir string) {
+	if dir == "" {
+		Source = AssetSource{}
+		return
+	}
+	Source = FallbackSource{DirSource{Dir: dir}, AssetSource{}}
+}
@@ -0,0 +1,134 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	. "github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/stats"
+)
+
+// LatencyReport is a second's latency histogram from a node.
+type LatencyReport struct {
+	TS   uint64           `json:"ts"`
+	Hist *stats.Histogram `json:"hist"`
+}
+
+func newInt() interface{}    { return new(int) }
+func newInt64() interface{}  { return new(int64) }
+func newString() interface{} { return new(string) }
+func newArgs() interface{}   { return new([]string) }
+
+func atLeastOne(p interface{}) error {
+	if n := *p.(*int); n < 1 {
+		return fmt.Errorf("%d is less than 1", n)
+	}
+	return nil
+}
+
+func collLetter(p interface{}) error {
+	if _, ok := LetterToColl[*p.(*string)]; !ok {
+		return fmt.Errorf("Unknown collection %q", *p.(*string))
+	}
+	return nil
+}
+
+// Engine messages that aren't control commands. Every other engine
+// message is sent reliably, each node it's for acking it.
+var internal = map[string]bool{"ONCE": true, "DONE": true, "EXIT": true}
+
+// The messages the engine and the UI take, and their payloads.
+func init() {
+	for name, t := range map[string]cluster.MessageType{
+		// Engine commands
+		"START":        {Targeted: true, Role: cluster.Operator},
+		"STOP":         {Targeted: true, Role: cluster.Operator},
+		"STARTAT":      {Role: cluster.Operator, Payload: newInt64}, // Unix ns
+		"STOPAT":       {Role: cluster.Operator, Payload: newInt64},
+		"DISARM":       {Role: cluster.Operator},
+		"ONCE":         {Role: cluster.Admin}, // For testing
+		"DONE":         {Role: cluster.Admin},
+		"EXIT":         {Role: cluster.Admin},
+		"DIE":          {Role: cluster.Admin}, // Just the target, or every node
+		"PROCS":        {Role: cluster.Operator, Payload: newInt, Check: atLeastOne},
+		"TARGETQPS":    {Role: cluster.Operator, Payload: newInt, Check: atLeastOne},
+		"CLUSTERQPS":   {Role: cluster.Operator, Payload: newInt, Check: atLeastOne},
+		"COLLSTART":    {Targeted: true, Role: cluster.Operator, Payload: newString, Check: collLetter},
+		"COLLSTOP":     {Targeted: true, Role: cluster.Operator, Payload: newString, Check: collLetter},
+		"DB":           {Role: cluster.Admin, Payload: newString},
+		"DBTARGETS":    {Role: cluster.Admin, Payload: func() interface{} { return new(TargetSet) }, Check: checkTargets},
+		"BULK":         {Role: cluster.Operator, Payload: newArgs, Check: checkBulk},
+		"READS":        {Role: cluster.Operator, Payload: newArgs, Check: checkReads},
+		"WRITECONCERN": {Role: cluster.Operator, Payload: newString, Check: checkWriteConcern},
+		"VERIFYRESET":  {Role: cluster.Operator},
+		"GUARDRAILS":   {Role: cluster.Operator, Payload: newArgs, Check: checkGuardrails},
+		"ABORT":        {Role: cluster.Operator, Payload: newString}, // Why
+	} {
+		t.Engine, t.Control = true, !internal[name]
+		cluster.RegisterMessage(name, t)
+	}
+	for name, t := range map[string]cluster.MessageType{
+		// What nodes tell the UI, which only admins may send
+		"STARTED":         {},
+		"STOPPED":         {},
+		"QPS":             {Payload: func() interface{} { return new([2]uint64) }}, // QPS, unix ms
+		"LATENCY":         {Payload: func() interface{} { return new(LatencyReport) }},
+		"ERRORS":          {Payload: func() interface{} { return new(stats.Counts) }},
+		"READSTATS":       {Payload: func() interface{} { return new(ReadStats) }},
+		"REPLAYS":         {Payload: func() interface{} { return new(map[string]ReplayCount) }},
+		"PROFILE":         {Payload: func() interface{} { return new(ProfileProgress) }},
+		"PROFILESTAGE":    {Payload: func() interface{} { return new(ProfileProgress) }},
+		"PROFILEDONE":     {Payload: func() interface{} { return new(ProfileProgress) }},
+		"SEARCH":          {Payload: func() interface{} { return new(SearchResult) }},
+		"SEARCHDONE":      {Payload: func() interface{} { return new(SearchResult) }},
+		"PROCSAT":         {Payload: newInt},
+		"TARGETQPSAT":     {Payload: newInt},
+		"CLUSTERQPSAT":    {Payload: newInt},
+		"COLLSTARTED":     {Payload: newString},
+		"COLLSTOPPED":     {Payload: newString},
+		"DBSWITCHED":      {Payload: newString},
+		"DBTARGETSSET":    {Payload: newInt64}, // The new version
+		"BULKSET":         {Payload: newString},
+		"READSSET":        {Payload: newString},
+		"WRITECONCERNSET": {Payload: newString},
+		"ARMED":           {Payload: newString},
+		"SKEW":            {Payload: newString},
+		"GUARDRAILSSET":   {Payload: newString},
+		"ABORTED":         {Payload: newString},
+	} {
+		cluster.RegisterMessage(name, t)
+	}
+}
+
+func checkBulk(p interface{}) error {
+	_, _, _, err := parseBulk(*p.(*[]string))
+	return err
+}
+
+func checkReads(p interface{}) error {
+	_, _, err := parseReads(*p.(*[]string))
+	return err
+}
+
+func checkGuardrails(p interface{}) error {
+	_, err := ParseGuardrails(*p.(*[]string))
+	return err
+}
+
+func checkWriteConcern(p interface{}) error {
+	_, err := ParseWriteConcern(*p.(*string))
+	return err
+}
+
+func checkTargets(p interface{}) error {
+	set := p.(*TargetSet)
+	if len(set.Targets) == 0 {
+		return fmt.Errorf("No DB targets")
+	}
+	for _, t := range set.Targets {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
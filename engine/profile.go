@@ -0,0 +1,302 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+)
+
+// Duration is a time.Duration that reads "90s" or "5m" from JSON.
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) (err error) {
+	var s string
+	if err = json.Unmarshal(b, &s); err != nil {
+		return
+	}
+	d.Duration, err = time.ParseDuration(s)
+	return
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// Stage is one part of a load profile. QPS is per node, like
+// TARGETQPS.
+//
+//	ramp:  From to To in a straight line
+//	step:  From to To in Steps even stairs
+//	spike: From, jumping to Peak for SpikeFor starting at SpikeAt
+//	       (default the middle of the stage)
+//	sine:  between From and To over each Period (default Duration),
+//	       starting at From; a diurnal curve
+//	soak:  From for the whole stage
+//
+// Procs, if set, is applied when the stage starts.
+type Stage struct {
+	Kind     string
+	Duration Duration
+	From     int
+	To       int
+	Steps    int
+	Peak     int
+	SpikeAt  Duration
+	SpikeFor Duration
+	Period   Duration
+	Procs    int
+}
+
+// Target is the QPS at elapsed time into the stage.
+func (s Stage) Target(elapsed time.Duration) int {
+	frac := 1.0
+	if s.Duration.Duration > 0 {
+		frac = math.Min(float64(elapsed)/float64(s.Duration.Duration), 1)
+	}
+	var target float64
+	switch s.Kind {
+	case "ramp":
+		target = float64(s.From) + frac*float64(s.To-s.From)
+	case "step":
+		steps := s.Steps
+		if steps < 1 {
+			steps = 1
+		}
+		stair := math.Min(math.Floor(frac*float64(steps)), float64(steps-1))
+		if steps > 1 {
+			target = float64(s.From) + stair*float64(s.To-s.From)/float64(steps-1)
+		} else {
+			target = float64(s.From)
+		}
+	case "spike":
+		at := s.SpikeAt.Duration
+		if at == 0 {
+			at = s.Duration.Duration / 2
+		}
+		target = float64(s.From)
+		if elapsed >= at && elapsed < at+s.SpikeFor.Duration {
+			target = float64(s.Peak)
+		}
+	case "sine":
+		period := s.Period.Duration
+		if period == 0 {
+			period = s.Duration.Duration
+		}
+		phase := 2 * math.Pi * float64(elapsed) / float64(period)
+		target = float64(s.From) + float64(s.To-s.From)*(1-math.Cos(phase))/2
+	default: // soak
+		target = float64(s.From)
+	}
+	if target < 1 { // A zero rate would stop the ticker cold.
+		target = 1
+	}
+	return int(target + 0.5)
+}
+
+// Profile is a sequence of stages run across the whole cluster, which
+// is stopped at the end.
+type Profile struct {
+	Name   string
+	Stages []Stage
+}
+
+func (p *Profile) Validate() error {
+	if len(p.Stages) == 0 {
+		return fmt.Errorf("Profile %q has no stages", p.Name)
+	}
+	for i, s := range p.Stages {
+		switch s.Kind {
+		case "ramp", "step", "spike", "sine", "soak":
+		default:
+			return fmt.Errorf("Stage %d of profile %q has unknown kind %q", i+1, p.Name, s.Kind)
+		}
+		if s.Duration.Duration <= 0 {
+			return fmt.Errorf("Stage %d of profile %q needs a duration", i+1, p.Name)
+		}
+	}
+	return nil
+}
+
+// Total is how long the whole profile runs.
+func (p *Profile) Total() (total time.Duration) {
+	for _, s := range p.Stages {
+		total += s.Duration.Duration
+	}
+	return
+}
+
+func ParseProfile(b []byte) (*Profile, error) {
+	p := new(Profile)
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func LoadProfile(path string) (*Profile, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProfile(b)
+}
+
+// ProfileProgress is sent to the UI every second while a profile runs.
+type ProfileProgress struct {
+	Name    string  `json:"name"`
+	Stage   int     `json:"stage"` // From 1
+	Stages  int     `json:"stages"`
+	Kind    string  `json:"kind"`
+	Elapsed float64 `json:"elapsed"` // Seconds
+	Total   float64 `json:"total"`
+	Target  int     `json:"target"`
+	Done    bool    `json:"done"`
+	TS      int64   `json:"ts"` // Unix milliseconds, to line up with the QPS chart
+}
+
+var (
+	profileMutex sync.Mutex
+	profileStop  chan bool
+	profileState *ProfileProgress
+)
+
+// CurrentProfile gives the progress of the running profile, or nil.
+func CurrentProfile() *ProfileProgress {
+	profileMutex.Lock()
+	defer profileMutex.Unlock()
+	if profileState == nil {
+		return nil
+	}
+	progress := *profileState
+	return &progress
+}
+
+// Held while a profile or search starts, so that only one of them can.
+var startMutex sync.Mutex
+
+// StartProfile runs p from this node, driving every node's TARGETQPS
+// and PROCS.
+func StartProfile(p *Profile) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	startMutex.Lock()
+	defer startMutex.Unlock()
+	if searching() {
+		return fmt.Errorf("Not while a search is going")
+	}
+	profileMutex.Lock()
+	defer profileMutex.Unlock()
+	if profileStop != nil {
+		return fmt.Errorf("Profile %q is already running", profileState.Name)
+	}
+	profileStop = make(chan bool)
+	profileState = &ProfileProgress{Name: p.Name, Stages: len(p.Stages), Total: p.Total().Seconds()}
+	go runProfile(p, profileState, profileStop)
+	return nil
+}
+
+// StopProfile ends the running profile early, stopping the cluster.
+func StopProfile() {
+	profileMutex.Lock()
+	defer profileMutex.Unlock()
+	if profileStop != nil {
+		close(profileStop)
+		profileStop = nil
+	}
+}
+
+func runProfile(p *Profile, state *ProfileProgress, stop chan bool) {
+	defer func() {
+		profileMutex.Lock()
+		// Unless another profile or a search has started since
+		// StopProfile, in which case stopping would stop that.
+		current := profileStop == stop || profileStop == nil
+		if profileStop == stop {
+			profileStop = nil
+		}
+		if profileState == state {
+			profileState = nil
+		}
+		state.Done = true
+		state.TS = time.Now().UnixNano() / int64(time.Millisecond)
+		progress := *state
+		profileMutex.Unlock()
+		if current && !searching() {
+			cluster.Clus.SendEngineTo("*", "STOP")
+		}
+		sendProfile("PROFILEDONE", &progress)
+	}()
+	cluster.Log("Starting profile %s", p.Name)
+	cluster.Clus.SendEngineTo("*", "START")
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	start := time.Now()
+	stageStart := start
+	stage := -1
+	target := 0
+	for {
+		now := time.Now()
+		elapsed := now.Sub(start)
+		// Find the stage we're in.
+		var offset time.Duration
+		current := len(p.Stages)
+		for i, s := range p.Stages {
+			if elapsed < offset+s.Duration.Duration {
+				current = i
+				break
+			}
+			offset += s.Duration.Duration
+		}
+		if current == len(p.Stages) { // All done.
+			cluster.Log("Profile %s finished", p.Name)
+			return
+		}
+		s := p.Stages[current]
+		if current != stage {
+			stage, stageStart = current, start.Add(offset)
+			if s.Procs > 0 {
+				cluster.Clus.SendEngine("PROCS", s.Procs)
+			}
+		}
+		if t := s.Target(now.Sub(stageStart)); t != target {
+			target = t
+			cluster.Clus.SendEngine("TARGETQPS", target)
+		}
+
+		profileMutex.Lock()
+		newStage := state.Stage != stage+1
+		state.Stage = stage + 1
+		state.Kind = s.Kind
+		state.Elapsed = elapsed.Seconds()
+		state.Target = target
+		state.TS = now.UnixNano() / int64(time.Millisecond)
+		progress := *state
+		profileMutex.Unlock()
+		if newStage { // Annotate the charts
+			sendProfile("PROFILESTAGE", &progress)
+		}
+		sendProfile("PROFILE", &progress)
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			cluster.Log("Profile %s stopped early", p.Name)
+			return
+		}
+	}
+}
+
+func sendProfile(cmd string, progress *ProfileProgress) {
+	cluster.Clus.SendUI(cmd, progress)
+}
@@ -0,0 +1,340 @@
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	. "github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/stats"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Read workload settings. The read rate is READQPS, or READRATIO
+// reads per write at the last second's write QPS if that's set.
+var (
+	READQPS   = 0
+	READRATIO = 0.0
+	ReadProcs = 4 // Queries that can be waiting on Mongo at once
+)
+
+var (
+	MyReadQPS    uint64
+	LastReadQPS  uint64                // The last full second's MyReadQPS
+	ReadLatency  = stats.NewRecorder() // Of every dashboard query
+	ReadErrors   = stats.NewCounters() // Running totals since startup
+	ReadsSkipped uint64                // Reads due when every ReadProcs was busy
+
+	readLatencyTotal      = stats.NewHistogram()
+	readLatencyTotalMutex sync.Mutex
+)
+
+// ReadLatencyTotal gives a copy of every read latency recorded since
+// startup.
+func ReadLatencyTotal() *stats.Histogram {
+	readLatencyTotalMutex.Lock()
+	defer readLatencyTotalMutex.Unlock()
+	return readLatencyTotal.Copy()
+}
+
+// SetReads takes the arguments of a READS command: a QPS, and
+// optionally a ratio of reads to writes that overrides it.
+func SetReads(args []string) error {
+	qps, ratio, err := parseReads(args)
+	if err != nil {
+		return err
+	}
+	READQPS, READRATIO = qps, ratio
+	return nil
+}
+
+// parseReads gives what SetReads would set.
+func parseReads(args []string) (qps int, ratio float64, err error) {
+	if len(args) < 1 {
+		return 0, 0, fmt.Errorf("READS needs a QPS")
+	}
+	if qps, err = strconv.Atoi(args[0]); err != nil || qps < 0 {
+		return 0, 0, fmt.Errorf("Bad read QPS %q", args[0])
+	}
+	if len(args) > 1 {
+		if ratio, err = strconv.ParseFloat(args[1], 64); err != nil || ratio < 0 {
+			return 0, 0, fmt.Errorf("Bad read ratio %q", args[1])
+		}
+	}
+	return qps, ratio, nil
+}
+
+// ReadSettings is the READS arguments for the current settings.
+func ReadSettings() string {
+	return fmt.Sprintf("%d %g", READQPS, READRATIO)
+}
+
+// ReadRate is how many reads a second to issue right now.
+func ReadRate() int {
+	if READRATIO > 0 {
+		return int(READRATIO*float64(atomic.LoadUint64(&LastQPS)) + 0.5)
+	}
+	return READQPS
+}
+
+// readKey is a campaign the dashboard would be looking at.
+type readKey struct {
+	Campaign   bson.ObjectId `bson:"campaign"`
+	Advertiser string        `bson:"advertiser"`
+	Date       time.Time     `bson:"date"`
+}
+
+// A dashboard query against db for key.
+type readQuery func(db *mgo.Database, key readKey) error
+
+// The date range the dashboard shows around a key's date.
+func readRange(key readKey) bson.M {
+	return bson.M{"$gte": key.Date.Add(-7 * 24 * time.Hour), "$lt": key.Date.Add(24 * time.Hour)}
+}
+
+// Group total_data for an advertiser's date range by field, biggest
+// spend first, as the dashboard's breakdown tables do.
+func tdBreakdown(field string) readQuery {
+	return func(db *mgo.Database, key readKey) error {
+		var result []bson.M
+		return db.C(TdColl).Pipe([]bson.M{
+			{"$match": bson.M{"advertiser": key.Advertiser, "date": readRange(key)}},
+			{"$group": bson.M{
+				"_id":         "$" + field,
+				"impressions": bson.M{"$sum": "$impressions_won"},
+				"clicks":      bson.M{"$sum": "$banner_clicks"},
+				"spend":       bson.M{"$sum": "$spend"},
+			}},
+			{"$sort": bson.M{"spend": -1}},
+			{"$limit": 50},
+		}).All(&result)
+	}
+}
+
+// Same for the location and device collections, keyed as DetailsLog
+// writes them.
+func detailsBreakdown(coll, field string) readQuery {
+	return func(db *mgo.Database, key readKey) error {
+		var result []bson.M
+		return db.C(coll).Pipe([]bson.M{
+			{"$match": bson.M{CAMPAIGN_KEY: key.Campaign.Hex(), DATE_KEY: readRange(key)}},
+			{"$group": bson.M{
+				"_id":         "$" + field,
+				"impressions": bson.M{"$sum": "$" + WINS},
+				"spend":       bson.M{"$sum": "$" + SPEND},
+			}},
+			{"$sort": bson.M{"impressions": -1}},
+			{"$limit": 50},
+		}).All(&result)
+	}
+}
+
+// ReadQueries are the dashboard's queries, picked from evenly.
+var ReadQueries = map[string]readQuery{
+	"td_campaign": func(db *mgo.Database, key readKey) error {
+		var result []bson.M
+		return db.C(TdColl).Find(bson.M{"campaign": key.Campaign, "date": readRange(key)}).All(&result)
+	},
+	"td_advertiser": func(db *mgo.Database, key readKey) error {
+		var result []bson.M
+		return db.C(TdColl).Find(bson.M{"advertiser": key.Advertiser, "date": readRange(key)}).
+			Sort("-date").Limit(500).All(&result)
+	},
+	"td_by_site":        tdBreakdown("site"),
+	"td_by_exchange":    tdBreakdown("exchange"),
+	"td_by_ad_size":     tdBreakdown("ad_size"),
+	"location_by_city":  detailsBreakdown(LocColl, CITY_KEY),
+	"device_by_handset": detailsBreakdown(DeviceColl, HANDSET_KEY),
+	"funds": func(db *mgo.Database, key readKey) error {
+		var result bson.M
+		err := db.C(AdvertiserColl).Find(bson.M{"username": key.Advertiser}).Select(bson.M{"funds": 1}).One(&result)
+		if err == mgo.ErrNotFound { // Still a read the dashboard made
+			return nil
+		}
+		return err
+	},
+}
+
+var readQueryNames = func() (names []string) {
+	for name := range ReadQueries {
+		names = append(names, name)
+	}
+	return
+}()
+
+// sampleReadKeys picks campaigns and advertisers to query for out of
+// what's been written so far.
+func sampleReadKeys(db *mgo.Database) (keys []readKey, err error) {
+	err = db.C(TdColl).Find(bson.M{"campaign": bson.M{"$exists": true}}).
+		Select(bson.M{"campaign": 1, "advertiser": 1, "date": 1}).Limit(1000).All(&keys)
+	return
+}
+
+// How often the keys to read are sampled again, to take in what's been
+// written since.
+var readKeysEvery = 30 * time.Second
+
+// RunReads issues the dashboard queries at ReadRate until stop is
+// closed. Each run has its own stop, so a quick STOP and START can't
+// leave the last run's readers going.
+func RunReads(stop <-chan bool) {
+	work := make(chan bool)
+	defer close(work)
+	var keys []readKey
+	var keysMutex sync.RWMutex
+	for i := 0; i < ReadProcs; i++ {
+		go func() {
+			r := rand.New(rand.NewSource(time.Now().UnixNano()))
+			for range work {
+				keysMutex.RLock()
+				key := keys[r.Intn(len(keys))]
+				keysMutex.RUnlock()
+				name := readQueryNames[r.Intn(len(readQueryNames))]
+				readOnce(name, key)
+			}
+		}()
+	}
+
+	var ticker *time.Ticker
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	rate := 0
+	var sampled time.Time
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		if newRate := ReadRate(); newRate != rate || ticker == nil {
+			if ticker != nil {
+				ticker.Stop()
+			}
+			rate = newRate
+			if rate > 0 {
+				interval := time.Second / time.Duration(rate)
+				if interval < time.Nanosecond { // Past a billion a second
+					interval = time.Nanosecond
+				}
+				ticker = time.NewTicker(interval)
+			} else {
+				ticker = time.NewTicker(time.Second) // Just to check again
+			}
+		}
+		keysMutex.RLock()
+		haveKeys := len(keys) > 0
+		keysMutex.RUnlock()
+		if rate > 0 && (!haveKeys || time.Since(sampled) > readKeysEvery) {
+			var fresh []readKey
+			if session := readSession(); session != nil {
+				var err error
+				if fresh, err = sampleReadKeys(session.DB(DBName())); err != nil {
+					cluster.Log("Sampling keys to read: %s", err)
+				}
+				session.Close()
+			}
+			if len(fresh) > 0 {
+				keysMutex.Lock()
+				keys = fresh
+				keysMutex.Unlock()
+				sampled, haveKeys = time.Now(), true
+			}
+			if !haveKeys { // Nothing to ask about yet
+				select {
+				case <-stop:
+					return
+				case <-time.After(time.Second):
+				}
+				continue
+			}
+		}
+		// Check the rate again every second's worth of ticks.
+		ticks := rate
+		if ticks == 0 {
+			ticks = 1
+		}
+		for i := 0; i < ticks; i++ {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+			if rate == 0 {
+				break
+			}
+			select {
+			case work <- true:
+			default:
+				atomic.AddUint64(&ReadsSkipped, 1)
+			}
+		}
+	}
+}
+
+// readSession copies the live session for a reader, or gives nil
+// while switching databases (the writers will reconnect).
+func readSession() *mgo.Session {
+	DBLock.RLock()
+	defer DBLock.RUnlock()
+	if LiveDB == nil {
+		return nil
+	}
+	return LiveDB.Copy()
+}
+
+func readOnce(name string, key readKey) {
+	session := readSession()
+	if session == nil {
+		return
+	}
+	defer session.Close()
+
+	ReadErrors.Attempt()
+	start := time.Now()
+	err := ReadQueries[name](session.DB(DBName()), key)
+	ReadLatency.Record(time.Since(start))
+	if err != nil {
+		class := ReadErrors.Error(err)
+		ReadErrors.Fail()
+		cluster.Log("Reading %s (%s): %s", name, class, err)
+		return
+	}
+	ReadErrors.Succeed()
+	atomic.AddUint64(&MyReadQPS, 1)
+}
+
+// ReadStats is what MonitorQPS sends the UI about reads each second.
+type ReadStats struct {
+	TS      uint64            `json:"ts"`
+	QPS     uint64            `json:"qps"`
+	Target  int               `json:"target"`
+	Latency stats.Percentiles `json:"latency"`
+	Counts  stats.Counts      `json:"counts"`
+	Skipped uint64            `json:"skipped"`
+}
+
+// readSecond rolls over the read counters for MonitorQPS.
+func readSecond(ts uint64) (ReadStats, *stats.Histogram) {
+	qps := atomic.SwapUint64(&MyReadQPS, 0)
+	atomic.StoreUint64(&LastReadQPS, qps)
+	snapshot := ReadLatency.Snapshot()
+	readLatencyTotalMutex.Lock()
+	readLatencyTotal.Merge(snapshot)
+	readLatencyTotalMutex.Unlock()
+	return ReadStats{
+		TS:      ts,
+		QPS:     qps,
+		Target:  ReadRate(),
+		Latency: snapshot.Percentiles(),
+		Counts:  ReadErrors.Counts(),
+		Skipped: atomic.LoadUint64(&ReadsSkipped),
+	}, snapshot
+}
@@ -2,15 +2,11 @@ package engine
 
 import (
 	"bufio"
-	"bytes"
-	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"net"
 	"net/url"
 	"path/filepath"
-	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -19,7 +15,6 @@ import (
 
 	"github.com/lyfe-mobile/hitter/cluster"
 	. "github.com/lyfe-mobile/hitter/common"
-	"github.com/lyfe-mobile/hitter/data"
 	mgo "gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
@@ -89,7 +84,7 @@ func UnpackEncodedID(e string) (c Event, err error) {
 }
 
 // AggregateLog takes the name of a collection and an aggregation
-// function. It will find all files in the log directory that match
+// function. It will find all files in the log source that match
 // the collection name with `_static_` and something else added to the end.
 //
 // It will go through each file and split the lines into 2 or 3
@@ -100,14 +95,15 @@ func UnpackEncodedID(e string) (c Event, err error) {
 // "static" to "hist"
 
 func AggregateLog(log_path string, coll string, aggFunc AggFunc) {
-	log_file, err := data.Asset(log_path)
+	log_file, err := Source.Open(log_path)
 
 	if err != nil {
 		cluster.Log("Opening log file: %s\n", err.Error())
 		return
 	}
+	defer log_file.Close()
 
-	scanner := bufio.NewScanner(bytes.NewReader(log_file))
+	scanner := bufio.NewScanner(log_file)
 	lineCount := 0
 	for scanner.Scan() {
 		record := strings.Split(strings.TrimSpace(scanner.Text()), " ")
@@ -183,13 +179,24 @@ func tryReconnect(err error, message string) bool {
 	return true
 }
 
-func wrapMongo(collName string, f func(*mgo.Collection) (*mgo.ChangeInfo, error)) (doReturn, abort bool) {
+// wrapMongo runs f against collName once the rate limiter allows,
+// retrying on errors it can recover from. f writes docs documents,
+// which all count toward QPS; they take one rate limiter tick each
+// unless BulkByBatch.
+func wrapMongo(collName string, docs int, f func(*mgo.Collection) error) (doReturn, abort bool) {
+	ticks := docs
+	if _, _, byBatch := Bulk(); byBatch {
+		ticks = 1
+	}
+	waited := 0
 	defer func() {
 		if r := recover(); r != nil {
 			err, ok := r.(error)
 			if !ok {
 				panic(r)
 			}
+			Errors.Error(err)
+			Errors.Fail()
 			cluster.Log("When upserting/updating: %s", err)
 		}
 	}()
@@ -202,43 +209,180 @@ func wrapMongo(collName string, f func(*mgo.Collection) (*mgo.ChangeInfo, error)
 		if LiveDB == nil { // May have been switched
 			DBLock.RUnlock()
 			if err = DialMongo(); err != nil {
+				Errors.Error(err)
+				Errors.Fail()
 				cluster.Log("switching to Mongo DB %s: %s\n", WHICHDB, err.Error())
 				return
 			}
 			DBLock.RLock()
 		}
-		theColl := LiveDB.DB(MONGODB[WHICHDB]).C(collName)
+		theColl := LiveDB.DB(DBName()).C(collName)
 		DBLock.RUnlock()
 		select {
 		case <-perSecTicker.C: // Wait until we can go
-			_, err = f(theColl)
+			if waited++; waited < ticks {
+				continue
+			}
+			waited = 0
+			Errors.Attempt()
+			atomic.AddInt64(&InFlight, 1)
+			start := time.Now()
+			err = f(theColl)
+			Latency.Record(time.Since(start))
+			atomic.AddInt64(&InFlight, -1)
 			if err == nil {
-				atomic.AddUint64(&MyQPS, 1)
+				Errors.Succeed()
+				atomic.AddUint64(&MyQPS, uint64(docs))
+				atomic.AddUint64(CollOps[collName], uint64(docs))
 				return
 			}
-			if !tryReconnect(err, fmt.Sprintf("updating %s", collName)) { // failed, try reconnecting.
-
+			class := Errors.Error(err)
+			if !tryReconnect(err, fmt.Sprintf("updating %s (%s)", collName, class)) { // failed, try reconnecting.
+				Errors.Fail()
 				return
 			}
+			Errors.Retry()
 		case <-tickerChange: // Redo! Ticker changed.
 		}
 	}
 }
 
 func Upsert(collName, encodedID string, set_fields, inc_fields bson.M) (doReturn, abort bool) {
-	return wrapMongo(collName, func(theColl *mgo.Collection) (*mgo.ChangeInfo, error) {
-		return theColl.Upsert(
+	return wrapMongo(collName, 1, func(theColl *mgo.Collection) error {
+		_, err := theColl.Upsert(
 			bson.M{"encoded_id": encodedID},
 			bson.M{"$set": set_fields, "$inc": inc_fields})
+		return err
 	})
 }
 
 func Update(collName string, selector interface{}, update interface{}) (doReturn, abort bool) {
-	return wrapMongo(collName, func(theColl *mgo.Collection) (*mgo.ChangeInfo, error) {
-		return nil, theColl.Update(selector, update)
+	return wrapMongo(collName, 1, func(theColl *mgo.Collection) error {
+		return theColl.Update(selector, update)
+	})
+}
+
+// BulkUpsert sends selector/update pairs as one Bulk operation.
+func BulkUpsert(collName string, pairs []interface{}) (doReturn, abort bool) {
+	_, ordered, _ := Bulk()
+	return wrapMongo(collName, len(pairs)/2, func(theColl *mgo.Collection) error {
+		bulk := theColl.Bulk()
+		if !ordered {
+			bulk.Unordered()
+		}
+		bulk.Upsert(pairs...)
+		_, err := bulk.Run()
+		return err
 	})
 }
 
+// Bulk write settings. A BulkSize of 0 or 1 means one Upsert per
+// encoded ID, as the aggregator has always done. Once writers are
+// going they're read through Bulk and changed through SetBulk.
+var (
+	BulkSize    = 0
+	BulkOrdered = true
+	BulkByBatch = false // One rate limiter tick per batch instead of per document
+	bulkMutex   sync.Mutex
+)
+
+// Bulk gives the current bulk write settings.
+func Bulk() (size int, ordered, byBatch bool) {
+	bulkMutex.Lock()
+	defer bulkMutex.Unlock()
+	return BulkSize, BulkOrdered, BulkByBatch
+}
+
+// upserter sends a log's upserts to coll, either as they come or in
+// batches of BulkSize. Flush must be called to send the last batch.
+type upserter struct {
+	coll  string
+	size  int
+	pairs []interface{}
+}
+
+func newUpserter(coll string) *upserter {
+	size, _, _ := Bulk()
+	return &upserter{coll: coll, size: size}
+}
+
+func (u *upserter) Upsert(encodedID string, set_fields, inc_fields bson.M) (doReturn, abort bool) {
+	if u.size <= 1 {
+		return Upsert(u.coll, encodedID, set_fields, inc_fields)
+	}
+	u.pairs = append(u.pairs,
+		bson.M{"encoded_id": encodedID},
+		bson.M{"$set": set_fields, "$inc": inc_fields})
+	if len(u.pairs)/2 >= u.size {
+		return u.Flush()
+	}
+	return
+}
+
+func (u *upserter) Flush() (doReturn, abort bool) {
+	if len(u.pairs) == 0 {
+		return
+	}
+	pairs := u.pairs
+	u.pairs = nil
+	return BulkUpsert(u.coll, pairs)
+}
+
+// SetBulk takes "<size> <ordered|unordered> <doc|batch>", the last
+// two being optional.
+func SetBulk(args []string) error {
+	bulkMutex.Lock()
+	defer bulkMutex.Unlock()
+	size, ordered, byBatch, err := parseBulk(args)
+	if err != nil {
+		return err
+	}
+	BulkSize, BulkOrdered, BulkByBatch = size, ordered, byBatch
+	return nil
+}
+
+// parseBulk gives what SetBulk would set. bulkMutex has to be held.
+func parseBulk(args []string) (size int, ordered, byBatch bool, err error) {
+	if len(args) < 1 {
+		err = fmt.Errorf("Bulk settings need a batch size")
+		return
+	}
+	if size, err = strconv.Atoi(args[0]); err != nil || size < 0 {
+		err = fmt.Errorf("Bad bulk batch size %q", args[0])
+		return
+	}
+	ordered, byBatch = BulkOrdered, BulkByBatch
+	for _, arg := range args[1:] {
+		switch arg {
+		case "ordered":
+			ordered = true
+		case "unordered":
+			ordered = false
+		case "doc":
+			byBatch = false
+		case "batch":
+			byBatch = true
+		default:
+			err = fmt.Errorf("Unknown bulk setting %q", arg)
+			return
+		}
+	}
+	return
+}
+
+// BulkSettings gives the current settings in SetBulk's format.
+func BulkSettings() string {
+	size, ordered, byBatch := Bulk()
+	order, rate := "ordered", "doc"
+	if !ordered {
+		order = "unordered"
+	}
+	if byBatch {
+		rate = "batch"
+	}
+	return fmt.Sprintf("%d %s %s", size, order, rate)
+}
+
 // CloseChannel doesn't panic when closing closed channels.
 func CloseChannel(c chan string) {
 	defer func() {
@@ -277,10 +421,32 @@ func AmDone(coll ...string) (doReturn, abort bool) {
 	return
 }
 
+// tdSetFields gives what a total data document is set to from its
+// encoded ID.
+func tdSetFields(encodedID string) (bson.M, error) {
+	td, err := UnpackEncodedID(encodedID)
+	if err != nil {
+		return nil, err
+	}
+	if !bson.IsObjectIdHex(td.Campaign) {
+		return nil, fmt.Errorf("Bad campaign ID %q", td.Campaign)
+	}
+	return bson.M{
+		"campaign":   bson.ObjectIdHex(td.Campaign),
+		"advertiser": td.Advertiser,
+		"date":       td.Date,
+		"site":       td.Site,
+		"exchange":   td.Exchange,
+		"ad_tag":     td.AdTag,
+		"ad_size":    td.AdSize,
+	}, nil
+}
+
 func TotalDataLog(log_path string) (abort bool) {
 	log_agg := make(map[string]map[string]float64)
 	AggregateLog(log_path, TdColl, makeAggregator("totaldata", log_agg))
 
+	upserts := newUpserter(TdColl)
 	for encodedID, update_fields := range log_agg {
 		inc_fields := bson.M{
 			"impressions_won":  update_fields[WINS],
@@ -289,45 +455,84 @@ func TotalDataLog(log_path string) (abort bool) {
 			"banner_clicks":    update_fields[CLICKS],
 			"spend":            update_fields[SPEND],
 		}
-		td, err := UnpackEncodedID(encodedID)
+		set_fields, err := tdSetFields(encodedID)
 		if err != nil {
-			cluster.Log("Unpacking Encoded TD ID\n")
+			cluster.Log("Unpacking Encoded TD ID: %s", err)
 			continue
 		}
 
-		set_fields := bson.M{
-			"campaign":   bson.ObjectIdHex(td.Campaign),
-			"advertiser": td.Advertiser,
-			"date":       td.Date,
-			"site":       td.Site,
-			"exchange":   td.Exchange,
-			"ad_tag":     td.AdTag,
-			"ad_size":    td.AdSize,
-		}
-
 		var doReturn bool
-		if doReturn, abort = Upsert(TdColl, encodedID, set_fields, inc_fields); doReturn {
+		if doReturn, abort = upserts.Upsert(encodedID, set_fields, inc_fields); doReturn {
 			return
 		}
 	}
+	_, abort = upserts.Flush()
 	return
 }
 
+// The keys in every details log's encoded IDs, then the ones in each
+// log's after them.
+var detailsCommonKeys = []string{CAMPAIGN_KEY, ADVERTISER_KEY, DATE_KEY, EXCHANGE_KEY}
+
+var detailsKeys = map[string][]string{
+	LocColl:    {CITY_KEY, STATE_KEY, COUNTRY_KEY},
+	DeviceColl: {HANDSET_KEY, OS_KEY, OSV_KEY, CARRIER_KEY},
+}
+
+// detailsSetFields gives what a details document is set to from its
+// encoded ID, which holds record_keys.
+func detailsSetFields(encodedID string, record_keys []string) (bson.M, error) {
+	dest, err := base64.URLEncoding.DecodeString(encodedID)
+	if err != nil {
+		return nil, fmt.Errorf("Decoding encodedID: %s", err)
+	}
+	var items []interface{}
+	if err = json.Unmarshal(dest, &items); err != nil {
+		return nil, fmt.Errorf("unmarshaling details encodedID: %s", err)
+	}
+	if len(record_keys) > len(items) {
+		return nil, fmt.Errorf("Too few fields in log")
+	}
+	when, ok := items[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("Date in details log isn't a string: %v", items[2])
+	}
+	date, err := time.Parse(time.RFC3339, when)
+	if err != nil {
+		// Old-style date, parse in our own location
+		// At some point this should probably be removed.
+		date, err = time.ParseInLocation("2006-01-02 15:04:05", when, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("Parsing time in details log: %s", err)
+		}
+	}
+
+	items[2] = date
+	set_fields := bson.M{}
+	for i, key := range record_keys {
+		set_fields[key] = items[i]
+	}
+	return set_fields, nil
+}
+
+// setFields gives what the log functions set a total data or details
+// document to from its encoded ID, or why they skip it.
+func setFields(coll, encodedID string) (bson.M, error) {
+	if coll == TdColl {
+		return tdSetFields(encodedID)
+	}
+	return detailsSetFields(encodedID, append(append([]string{}, detailsCommonKeys...), detailsKeys[coll]...))
+}
+
 // Takes a string log type which is used as the collection selector.
 // record_keys is a list of additional Mongo keys that are expected
 //    in the decoded ID for this log type (after the common keys)
 func DetailsLog(log_path string, log_type string, record_keys ...string) (abort bool) {
-	common_keys := []string{
-		CAMPAIGN_KEY,
-		ADVERTISER_KEY,
-		DATE_KEY,
-		EXCHANGE_KEY,
-	}
-
 	log_agg := make(map[string]map[string]float64)
 	AggregateLog(log_path, log_type, makeAggregator(log_type, log_agg))
-	record_keys = append(common_keys, record_keys...)
+	record_keys = append(append([]string{}, detailsCommonKeys...), record_keys...)
 
+	upserts := newUpserter(log_type)
 	for encodedID, update_fields := range log_agg {
 		inc_fields := bson.M{
 			WINS:             update_fields[WINS],
@@ -337,67 +542,31 @@ func DetailsLog(log_path string, log_type string, record_keys ...string) (abort
 			SPEND:            update_fields[SPEND],
 		}
 
-		dest, err := base64.URLEncoding.DecodeString(encodedID)
-		if err != nil {
-			cluster.Log("Decoding encodedID: %s", err.Error())
-			continue
-		}
-		var items []interface{}
-		err = json.Unmarshal(dest, &items)
-		if err != nil {
-			cluster.Log("unmarshaling details encodedID: %s", err.Error())
-			continue
-		}
-
-		date, err := time.Parse(time.RFC3339, items[2].(string))
+		set_fields, err := detailsSetFields(encodedID, record_keys)
 		if err != nil {
-			// Old-style date, parse in our own location
-			// At some point this should probably be removed.
-			date, err = time.ParseInLocation("2006-01-02 15:04:05", items[2].(string), time.Local)
-			if err != nil {
-				cluster.Log("Parsing time in details log: %s", err.Error())
-				continue
-			}
-		}
-
-		items[2] = date
-		set_fields := bson.M{}
-		if len(record_keys) > len(items) {
-			cluster.Log("Too few fields in log\n")
+			cluster.Log("%s", err)
 			continue
 		}
-		for i, key := range record_keys {
-			set_fields[key] = items[i]
-		}
 		var doReturn bool
-		if doReturn, abort = Upsert(log_type, encodedID, set_fields, inc_fields); doReturn {
+		if doReturn, abort = upserts.Upsert(encodedID, set_fields, inc_fields); doReturn {
 			return
 		}
 
 	}
+	_, abort = upserts.Flush()
 	return
 }
 
 func LocLog(log_path string) (abort bool) {
-	return DetailsLog(log_path,
-		LocColl,
-		CITY_KEY,
-		STATE_KEY,
-		COUNTRY_KEY,
-	)
+	return DetailsLog(log_path, LocColl, detailsKeys[LocColl]...)
 }
 
 func DeviceLog(log_path string) (abort bool) {
-	return DetailsLog(log_path,
-		DeviceColl,
-		HANDSET_KEY,
-		OS_KEY,
-		OSV_KEY,
-		CARRIER_KEY,
-	)
+	return DetailsLog(log_path, DeviceColl, detailsKeys[DeviceColl]...)
 }
 
-func AdvertiserLog(log_path string) (abort bool) {
+// advertiserSpends totals up spend per advertiser in an advertiser log.
+func advertiserSpends(log_path string) map[string]float64 {
 	log_agg := make(map[string]float64)
 	AggregateLog(log_path, AdvertiserColl, func(record []string) {
 		if len(record) < 2 {
@@ -412,6 +581,11 @@ func AdvertiserLog(log_path string) (abort bool) {
 		}
 		log_agg[advertiser] += spend
 	})
+	return log_agg
+}
+
+func AdvertiserLog(log_path string) (abort bool) {
+	log_agg := advertiserSpends(log_path)
 	var doReturn bool
 	for advertiser, tot_spend := range log_agg {
 		if doReturn, abort = Update("advertiser", bson.M{"username": advertiser}, bson.M{"$inc": bson.M{"funds": -tot_spend}}); doReturn {
@@ -422,9 +596,11 @@ func AdvertiserLog(log_path string) (abort bool) {
 	return
 }
 
-func CampaignLog(log_path string) (abort bool) {
-	spends := make(map[string]float64)
-	imps := make(map[string]int)
+// campaignTotals totals up spend and impressions per campaign in a
+// campaign log.
+func campaignTotals(log_path string) (spends map[string]float64, imps map[string]int) {
+	spends = make(map[string]float64)
+	imps = make(map[string]int)
 	AggregateLog(log_path, CampaignColl, func(record []string) {
 		if len(record) < 2 {
 			cluster.Log("Too few fields in campaign log, expected 2\n")
@@ -439,9 +615,17 @@ func CampaignLog(log_path string) (abort bool) {
 		spends[campaign_id] += spend
 		imps[campaign_id] += 1
 	})
+	return
+}
 
+func CampaignLog(log_path string) (abort bool) {
+	spends, imps := campaignTotals(log_path)
 	var doReturn bool
 	for campaign_id, spend := range spends {
+		if !bson.IsObjectIdHex(campaign_id) {
+			cluster.Log("Bad campaign ID %q in campaign log", campaign_id)
+			continue
+		}
 		if doReturn, abort = Update("campaign",
 			bson.M{"_id": bson.ObjectIdHex(campaign_id)}, bson.M{
 				"$inc": bson.M{
@@ -481,23 +665,9 @@ var LogRotation = map[string]time.Duration{
 
 const AggLogDir = "logs"
 
+// Glob finds logs matching pattern in the current Source.
 func Glob(pattern string) (matches []string, err error) {
-	names := data.AssetNames()
-	sort.Strings(names)
-	var matched bool
-	dir, file := filepath.Split(pattern)
-
-	for _, n := range names {
-		n = n[len(dir):]
-		matched, err = filepath.Match(file, n)
-		if err != nil {
-			return
-		}
-		if matched {
-			matches = append(matches, filepath.Join(dir, n))
-		}
-	}
-	return
+	return Source.Glob(pattern)
 }
 
 func RunLogs() {
@@ -528,7 +698,9 @@ func RunLogs() {
 
 			// Call its function if it exists
 			if f, ok := LogAggregation[coll]; ok {
-				if f(log_path) { // True means abort.
+				abort := f(log_path)
+				countReplay(log_path, !abort && Running && IsActive(coll))
+				if abort {
 					alreadyCounted = true
 					return
 				}
@@ -545,39 +717,49 @@ func DialMongo() error {
 		LiveDB = nil
 	}
 
-	var session *mgo.Session
-	var err error
-	if WHICHDB == "nodb" {
-		dialInfo, err := mgo.ParseURL(DBS[WHICHDB]) //MONGOSTRING)
-		if err != nil {
-			panic(err)
-		}
-		dialInfo.DialServer = func(addr *mgo.ServerAddr) (net.Conn, error) {
-			conn, err := tls.Dial("tcp", addr.String(), &tls.Config{
-				InsecureSkipVerify: true,
-			})
-			return conn, err
-		}
-		dialInfo.Timeout = time.Second
-		session, err = mgo.DialWithInfo(dialInfo)
-	} else {
-		session, err = mgo.Dial(DBS[WHICHDB])
+	target, ok := Target(WHICHDB)
+	if !ok {
+		return fmt.Errorf("Unknown DB target %s", WHICHDB)
 	}
-
+	info, err := target.DialInfo()
+	if err != nil {
+		return err
+	}
+	session, err := mgo.DialWithInfo(info)
 	if err != nil {
 		return err
 	}
 	if session == nil {
-		return fmt.Errorf("Session for %s is nil", DBS[WHICHDB])
+		return fmt.Errorf("Session for %s is nil", WHICHDB)
+	}
+	safe, err := ParseWriteConcern(WRITECONCERN)
+	if err != nil {
+		session.Close()
+		return err
 	}
 	session.SetSocketTimeout(time.Second * 2)
-	session.SetSafe(&mgo.Safe{W: 0})
-	session.SetPoolLimit(0)
+	session.SetSafe(safe)
+	session.SetPoolLimit(target.PoolSize)
 	DBSESSIONS[WHICHDB] = session
 	LiveDB = session
 	return nil
 }
 
+// SetWriteConcern switches the live session (and later ones) to spec.
+func SetWriteConcern(spec string) error {
+	safe, err := ParseWriteConcern(spec)
+	if err != nil {
+		return err
+	}
+	DBLock.Lock()
+	defer DBLock.Unlock()
+	WRITECONCERN = spec
+	if LiveDB != nil {
+		LiveDB.SetSafe(safe)
+	}
+	return nil
+}
+
 // Order to run the logs in
 var LogOrder = []string{
 	AdvertiserColl,
@@ -0,0 +1,260 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bradfitz/slice"
+	"github.com/lyfe-mobile/hitter/cluster"
+	. "github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/stats"
+	log "github.com/sirupsen/logrus"
+)
+
+// RunDir is where run records are kept, one JSON file per run. Empty
+// turns recording off.
+var RunDir = "runs"
+
+// RunConfig is how the node was set up when the run started.
+type RunConfig struct {
+	WhichDB      string   `json:"whichdb"`
+	Database     string   `json:"database"`
+	WriteConcern string   `json:"writeconcern"`
+	Bulk         string   `json:"bulk"`
+	Procs        int      `json:"procs"`
+	PerSec       int      `json:"persec"`
+	ClusterQPS   int      `json:"clusterqps"`
+	Collections  []string `json:"collections"` // The active ones
+	Source       string   `json:"source"`
+	Profile      string   `json:"profile,omitempty"`
+	Reads        string   `json:"reads"` // READS QPS and ratio
+}
+
+// RunSecond is one second of a run.
+type RunSecond struct {
+	TS       uint64            `json:"ts"` // Unix milliseconds
+	QPS      uint64            `json:"qps"`
+	Target   int               `json:"target"`
+	Latency  stats.Percentiles `json:"latency"`
+	Errors   uint64            `json:"errors"` // New this second
+	InFlight int64             `json:"inflight"`
+
+	Reads       uint64            `json:"reads"`
+	ReadLatency stats.Percentiles `json:"readlatency"`
+}
+
+// RunSummary sums up a whole run.
+type RunSummary struct {
+	Seconds    float64           `json:"seconds"`
+	Operations uint64            `json:"operations"`
+	MeanQPS    float64           `json:"meanqps"`
+	PeakQPS    uint64            `json:"peakqps"`
+	Latency    stats.Percentiles `json:"latency"`
+	Counts     stats.Counts      `json:"counts"` // Just this run's
+
+	Reads       uint64            `json:"reads"`
+	MeanReadQPS float64           `json:"meanreadqps"`
+	ReadLatency stats.Percentiles `json:"readlatency"`
+	ReadCounts  stats.Counts      `json:"readcounts"`
+}
+
+// RunRecord is everything kept about one START to STOP on this node.
+type RunRecord struct {
+	ID        string           `json:"id"`
+	Node      string           `json:"node"`
+	Start     time.Time        `json:"start"`
+	End       time.Time        `json:"end"`
+	Config    RunConfig        `json:"config"`
+	Series    []RunSecond      `json:"series"`
+	Summary   RunSummary       `json:"summary"`
+	Histogram *stats.Histogram `json:"histogram"` // Every latency in the run
+
+	ReadHistogram *stats.Histogram `json:"readhistogram"`
+	Aborted       string           `json:"aborted,omitempty"` // Why a guardrail stopped it
+
+	startCounts     stats.Counts
+	startReadCounts stats.Counts
+	lastErrors      uint64
+}
+
+var (
+	runMutex   sync.Mutex
+	currentRun *RunRecord
+)
+
+// beginRecord starts recording a run, if RunDir is set.
+func beginRecord() {
+	if RunDir == "" {
+		return
+	}
+	now := time.Now()
+	run := &RunRecord{
+		ID:        fmt.Sprintf("%s-%s", now.UTC().Format("20060102T150405.000000000"), cluster.HostName),
+		Node:      cluster.HostName,
+		Start:     now,
+		Histogram: stats.NewHistogram(),
+
+		ReadHistogram: stats.NewHistogram(),
+		Config: RunConfig{
+			WhichDB:      WHICHDB,
+			Database:     DBName(),
+			WriteConcern: WRITECONCERN,
+			Bulk:         BulkSettings(),
+			Procs:        cluster.PROCS,
+			PerSec:       cluster.PERSEC,
+			ClusterQPS:   cluster.CLUSTERQPS,
+			Source:       fmt.Sprint(Source),
+			Reads:        ReadSettings(),
+		},
+		startCounts:     Errors.Counts(),
+		startReadCounts: ReadErrors.Counts(),
+	}
+	run.lastErrors = run.startCounts.TotalErrors()
+	for _, coll := range LogOrder {
+		if IsActive(coll) {
+			run.Config.Collections = append(run.Config.Collections, coll)
+		}
+	}
+	if p := CurrentProfile(); p != nil {
+		run.Config.Profile = p.Name
+	}
+	runMutex.Lock()
+	currentRun = run
+	runMutex.Unlock()
+}
+
+// recordSecond adds a second from MonitorQPS to the current run.
+func recordSecond(ts, qps uint64, hist *stats.Histogram, counts stats.Counts, reads ReadStats, readHist *stats.Histogram) {
+	runMutex.Lock()
+	defer runMutex.Unlock()
+	run := currentRun
+	if run == nil {
+		return
+	}
+	errors := counts.TotalErrors()
+	run.Series = append(run.Series, RunSecond{
+		TS:       ts,
+		QPS:      qps,
+		Target:   cluster.PERSEC,
+		Latency:  hist.Percentiles(),
+		Errors:   errors - run.lastErrors,
+		InFlight: atomic.LoadInt64(&InFlight),
+
+		Reads:       reads.QPS,
+		ReadLatency: reads.Latency,
+	})
+	run.lastErrors = errors
+	run.Histogram.Merge(hist)
+	run.ReadHistogram.Merge(readHist)
+}
+
+// currentRunID is the ID of the run being recorded, if there is one.
+func currentRunID() string {
+	runMutex.Lock()
+	defer runMutex.Unlock()
+	if currentRun == nil {
+		return ""
+	}
+	return currentRun.ID
+}
+
+// noteAbort records why the current run is being stopped early.
+func noteAbort(reason string) {
+	runMutex.Lock()
+	defer runMutex.Unlock()
+	if currentRun != nil {
+		currentRun.Aborted = reason
+	}
+}
+
+// endRecord sums up the current run and writes it to RunDir.
+func endRecord() {
+	runMutex.Lock()
+	run := currentRun
+	currentRun = nil
+	runMutex.Unlock()
+	if run == nil {
+		return
+	}
+	run.End = time.Now()
+	run.Summary = RunSummary{
+		Seconds: run.End.Sub(run.Start).Seconds(),
+		Latency: run.Histogram.Percentiles(),
+		Counts:  Errors.Counts().Sub(run.startCounts),
+
+		ReadLatency: run.ReadHistogram.Percentiles(),
+		ReadCounts:  ReadErrors.Counts().Sub(run.startReadCounts),
+	}
+	for _, second := range run.Series {
+		run.Summary.Reads += second.Reads
+		run.Summary.Operations += second.QPS
+		if second.QPS > run.Summary.PeakQPS {
+			run.Summary.PeakQPS = second.QPS
+		}
+	}
+	if run.Summary.Seconds > 0 {
+		run.Summary.MeanQPS = float64(run.Summary.Operations) / run.Summary.Seconds
+		run.Summary.MeanReadQPS = float64(run.Summary.Reads) / run.Summary.Seconds
+	}
+	if err := SaveRun(run); err != nil {
+		cluster.Log("Saving run %s: %s", run.ID, err)
+		return
+	}
+	cluster.Log("Saved run %s", run.ID)
+}
+
+// SaveRun writes run to RunDir.
+func SaveRun(run *RunRecord) error {
+	if err := os.MkdirAll(RunDir, 0755); err != nil {
+		return err
+	}
+	b, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(RunDir, run.ID+".json"), b, 0644)
+}
+
+// LoadRun reads back the run with this ID.
+func LoadRun(id string) (*RunRecord, error) {
+	if strings.ContainsAny(id, `/\`) {
+		return nil, fmt.Errorf("Bad run ID %q", id)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(RunDir, id+".json"))
+	if err != nil {
+		return nil, err
+	}
+	run := new(RunRecord)
+	if err := json.Unmarshal(b, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// Runs gives every saved run without its series, newest first. Runs
+// that can't be read are left out.
+func Runs() ([]*RunRecord, error) {
+	names, err := filepath.Glob(filepath.Join(RunDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	runs := []*RunRecord{}
+	for _, name := range names {
+		run, err := LoadRun(strings.TrimSuffix(filepath.Base(name), ".json"))
+		if err != nil {
+			log.Warnf("Skipping run %s: %s", name, err)
+			continue
+		}
+		run.Series, run.Histogram, run.ReadHistogram = nil, nil, nil
+		runs = append(runs, run)
+	}
+	slice.Sort(runs, func(i, j int) bool { return runs[i].Start.After(runs[j].Start) })
+	return runs, nil
+}
@@ -0,0 +1,434 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/stats"
+)
+
+// SearchConfig is how a max-throughput search goes. QPS is per node,
+// like TARGETQPS. A step passes when the nodes get within MinAchieved
+// of their target between them, inside the latency and error SLOs
+// across the cluster.
+//
+// Procs go up in step with the target, keeping the ratio of PROCS to
+// TARGETQPS there was when the search started.
+type SearchConfig struct {
+	Start        int      // First target; default the current one
+	Factor       float64  // Raise the target this many times each step until one fails; default 2
+	Step         Duration // How long each step is held; default 30s
+	Settle       Duration // How much of each step to leave out while things settle; default a third
+	MaxP99       float64  // Milliseconds
+	MaxErrorRate float64  // Errors per attempted operation
+	MinAchieved  float64  // Fraction of the target that has to be met; default 0.95
+	Precision    float64  // Stop once the answer is known to within this fraction; default 0.05
+	MaxQPS       int      // Never try above this
+}
+
+func (c *SearchConfig) Validate() error {
+	if c.Start == 0 {
+		c.Start = cluster.PERSEC
+	}
+	if c.Factor == 0 {
+		c.Factor = 2
+	}
+	if c.Step.Duration == 0 {
+		c.Step.Duration = 30 * time.Second
+	}
+	if c.Settle.Duration == 0 {
+		c.Settle.Duration = c.Step.Duration / 3
+	}
+	if c.MinAchieved == 0 {
+		c.MinAchieved = 0.95
+	}
+	if c.Precision == 0 {
+		c.Precision = 0.05
+	}
+	switch {
+	case c.Start < 1:
+		return fmt.Errorf("Search needs to start at 1 QPS or more")
+	case c.Factor <= 1:
+		return fmt.Errorf("Search factor %g needs to be over 1", c.Factor)
+	case c.Settle.Duration >= c.Step.Duration:
+		return fmt.Errorf("Search steps of %s leave nothing after settling for %s", c.Step, c.Settle)
+	case c.MinAchieved < 0 || c.MinAchieved > 1:
+		return fmt.Errorf("Search MinAchieved %g needs to be between 0 and 1", c.MinAchieved)
+	case c.Precision <= 0 || c.Precision >= 1:
+		return fmt.Errorf("Search precision %g needs to be between 0 and 1", c.Precision)
+	case c.MaxP99 < 0 || c.MaxErrorRate < 0 || c.MaxQPS < 0:
+		return fmt.Errorf("Search limits can't be negative")
+	case c.MaxQPS > 0 && c.Start > c.MaxQPS:
+		return fmt.Errorf("Search can't start at %d QPS, over its maximum of %d", c.Start, c.MaxQPS)
+	}
+	return nil
+}
+
+func ParseSearchConfig(b []byte) (*SearchConfig, error) {
+	c := new(SearchConfig)
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SearchStep is one target tried, and how the cluster did at it; the
+// steps together are the latency curve.
+type SearchStep struct {
+	Phase      string            `json:"phase"` // "ramp" or "search"
+	Target     int               `json:"target"`
+	Procs      int               `json:"procs"`
+	Nodes      int               `json:"nodes"`      // Each driven at Target
+	ClusterQPS float64           `json:"clusterqps"` // Mean a second across them, once settled
+	QPS        float64           `json:"qps"`        // The same a node
+	Latency    stats.Percentiles `json:"latency"`
+	ErrorRate  float64           `json:"errorrate"`
+	OK         bool              `json:"ok"`
+	Why        string            `json:"why,omitempty"` // It failed
+}
+
+// Search finds the highest target that passes: raising it by Factor
+// until a step fails, then halving the gap between the highest that
+// passed and the lowest that failed.
+type Search struct {
+	Config SearchConfig
+	Steps  []SearchStep
+	best   int // Highest target passed
+	worst  int // Lowest target failed; 0 while ramping
+}
+
+func NewSearch(c SearchConfig) *Search {
+	return &Search{Config: c, Steps: []SearchStep{}}
+}
+
+// Next is the target to try next, or 0 once the search is done.
+func (s *Search) Next() int {
+	c := s.Config
+	if s.worst == 0 { // Ramping
+		if len(s.Steps) == 0 {
+			return c.Start
+		}
+		next := int(math.Ceil(float64(s.best) * c.Factor))
+		if c.MaxQPS > 0 && next > c.MaxQPS {
+			if s.best >= c.MaxQPS {
+				return 0
+			}
+			next = c.MaxQPS
+		}
+		return next
+	}
+	gap := s.worst - s.best
+	if gap <= 1 || float64(gap) <= c.Precision*float64(s.worst) {
+		return 0
+	}
+	return s.best + gap/2
+}
+
+// Note judges step against the SLOs and takes it into account.
+func (s *Search) Note(step SearchStep) SearchStep {
+	c := s.Config
+	step.Phase = "ramp"
+	if s.worst != 0 {
+		step.Phase = "search"
+	}
+	var why []string
+	if step.QPS < c.MinAchieved*float64(step.Target) {
+		why = append(why, fmt.Sprintf("reached %.0f of %d QPS", step.QPS, step.Target))
+	}
+	if c.MaxP99 > 0 && step.Latency.P99 > c.MaxP99 {
+		why = append(why, fmt.Sprintf("p99 latency %.1fms over %gms", step.Latency.P99, c.MaxP99))
+	}
+	if c.MaxErrorRate > 0 && step.ErrorRate > c.MaxErrorRate {
+		why = append(why, fmt.Sprintf("error rate %.4f over %g", step.ErrorRate, c.MaxErrorRate))
+	}
+	step.OK = len(why) == 0
+	step.Why = strings.Join(why, ", ")
+	if step.OK {
+		if step.Target > s.best {
+			s.best = step.Target
+		}
+	} else if s.worst == 0 || step.Target < s.worst {
+		s.worst = step.Target
+	}
+	s.Steps = append(s.Steps, step)
+	return step
+}
+
+// Best is the highest target that passed, and how the cluster did at
+// it; nil if none did.
+func (s *Search) Best() *SearchStep {
+	for i := len(s.Steps) - 1; i >= 0; i-- {
+		if step := s.Steps[i]; step.OK && step.Target == s.best {
+			return &step
+		}
+	}
+	return nil
+}
+
+// SearchResult is a search so far, sent to the UI after each step.
+type SearchResult struct {
+	Start      time.Time    `json:"start"`
+	Config     SearchConfig `json:"config"`
+	Steps      []SearchStep `json:"steps"`
+	Best       *SearchStep  `json:"best"`       // Nil until a step passes
+	Nodes      int          `json:"nodes"`      // Each driven at the same target
+	ClusterQPS float64      `json:"clusterqps"` // Best's QPS across the nodes it had
+	Done       bool         `json:"done"`
+	Stopped    bool         `json:"stopped"` // Before it was done
+	TS         int64        `json:"ts"`      // Unix milliseconds
+}
+
+var (
+	searchMutex sync.Mutex
+	searchStop  chan bool
+	searchStep  *stepWatch    // The step being measured
+	searchState *SearchResult // The current or last search
+)
+
+// stepWatch adds up what every node tells the UI over a search step,
+// as a headless run's runWatch does over the whole run.
+type stepWatch struct {
+	from  uint64 // Unix milliseconds of the first second counted; 0 while settling
+	qps   map[uint64]uint64
+	hists map[uint64]*stats.Histogram
+	first map[string]stats.Counts // Each node's running totals once settled
+	last  map[string]stats.Counts
+}
+
+func newStepWatch() *stepWatch {
+	return &stepWatch{
+		qps:   map[uint64]uint64{},
+		hists: map[uint64]*stats.Histogram{},
+		first: map[string]stats.Counts{},
+		last:  map[string]stats.Counts{},
+	}
+}
+
+// NoteSearchMessage gives the running search msg, one of those meant
+// for the UI, so that it can judge each step by what every node says.
+// Whoever takes the UI's messages passes it each.
+func NoteSearchMessage(msg cluster.Message) {
+	if msg.Type != "QPS" && msg.Type != "LATENCY" && msg.Type != "ERRORS" {
+		return
+	}
+	searchMutex.Lock()
+	defer searchMutex.Unlock()
+	w := searchStep
+	if w == nil {
+		return
+	}
+	node := msg.Sender
+	switch msg.Type {
+	case "QPS":
+		var qps [2]uint64 // QPS, date
+		msg.Decode(&qps)
+		if w.from != 0 && qps[1] >= w.from {
+			w.qps[qps[1]] += qps[0]
+		}
+	case "LATENCY":
+		var report LatencyReport
+		msg.Decode(&report)
+		if report.Hist != nil && w.from != 0 && report.TS >= w.from {
+			if w.hists[report.TS] == nil {
+				w.hists[report.TS] = stats.NewHistogram()
+			}
+			w.hists[report.TS].Merge(report.Hist)
+		}
+	case "ERRORS":
+		var counts stats.Counts
+		msg.Decode(&counts)
+		if _, ok := w.first[node]; !ok || w.from == 0 {
+			w.first[node] = counts
+		}
+		w.last[node] = counts
+	}
+}
+
+// LastSearch gives the running search so far, or the last one, or nil.
+func LastSearch() *SearchResult {
+	searchMutex.Lock()
+	defer searchMutex.Unlock()
+	if searchState == nil {
+		return nil
+	}
+	result := *searchState
+	result.Steps = append([]SearchStep{}, result.Steps...)
+	return &result
+}
+
+// StartSearch searches for the highest sustainable throughput from
+// this node, driving every node's TARGETQPS and PROCS together and
+// judging each step by the QPS, latency and errors of them all.
+func StartSearch(c SearchConfig) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	startMutex.Lock()
+	defer startMutex.Unlock()
+	if CurrentProfile() != nil {
+		return fmt.Errorf("Not while a profile runs")
+	}
+	searchMutex.Lock()
+	defer searchMutex.Unlock()
+	if searchStop != nil {
+		return fmt.Errorf("A search is already going")
+	}
+	searchStop = make(chan bool)
+	searchState = &SearchResult{Start: time.Now(), Config: c, Steps: []SearchStep{}}
+	go runSearch(NewSearch(c), searchState, searchStop)
+	return nil
+}
+
+// searching is whether a search is going.
+func searching() bool {
+	searchMutex.Lock()
+	defer searchMutex.Unlock()
+	return searchStop != nil
+}
+
+// StopSearch ends the running search early, stopping the cluster.
+func StopSearch() {
+	searchMutex.Lock()
+	defer searchMutex.Unlock()
+	if searchStop != nil {
+		close(searchStop)
+		searchStop = nil
+	}
+}
+
+func runSearch(s *Search, state *SearchResult, stop chan bool) {
+	stopped := false
+	defer func() {
+		searchMutex.Lock()
+		// Unless another search has started since StopSearch, in
+		// which case stopping would stop that.
+		current := searchStop == stop || searchStop == nil
+		if searchStop == stop {
+			searchStop = nil
+		}
+		state.Done, state.Stopped = true, stopped
+		state.TS = time.Now().UnixNano() / int64(time.Millisecond)
+		result := *state
+		searchMutex.Unlock()
+		if current && CurrentProfile() == nil { // Nor a profile
+			cluster.Clus.SendEngineTo("*", "STOP")
+		}
+		if result.Best != nil {
+			cluster.Log("Search done: %d QPS a node is sustainable, %.0f across %d nodes",
+				result.Best.Target, result.ClusterQPS, result.Nodes)
+		} else {
+			cluster.Log("Search done: no target was sustainable")
+		}
+		cluster.Clus.SendUI("SEARCHDONE", &result)
+	}()
+	base := cluster.PERSEC
+	if base < 1 { // No target yet, so keep to the procs there are at the first
+		base = s.Config.Start
+	}
+	procsPerQPS := float64(cluster.PROCS) / float64(base)
+	cluster.Log("Searching for the highest sustainable QPS from %d a node", s.Config.Start)
+	cluster.Clus.SendEngineTo("*", "START")
+	for target := s.Next(); target > 0; target = s.Next() {
+		procs := int(math.Max(1, math.Ceil(float64(target)*procsPerQPS)))
+		cluster.Clus.SendEngine("PROCS", procs)
+		cluster.Clus.SendEngine("TARGETQPS", target)
+		nodes := cluster.Clus.Count()
+		step, ok := measureStep(s.Config, stop, nodes)
+		if !ok {
+			cluster.Log("Search stopped early")
+			stopped = true
+			return
+		}
+		step.Target, step.Procs = target, procs
+		step = s.Note(step)
+		if step.OK {
+			cluster.Log("Search: %d QPS a node holds, %.0f QPS across %d nodes, p99 %.1fms",
+				target, step.ClusterQPS, step.Nodes, step.Latency.P99)
+		} else {
+			cluster.Log("Search: %d QPS a node doesn't hold: %s", target, step.Why)
+		}
+
+		searchMutex.Lock()
+		state.Steps = append([]SearchStep{}, s.Steps...)
+		state.Best = s.Best()
+		state.Nodes = nodes
+		if state.Best != nil {
+			state.ClusterQPS = state.Best.ClusterQPS
+		}
+		state.TS = time.Now().UnixNano() / int64(time.Millisecond)
+		progress := *state
+		searchMutex.Unlock()
+		cluster.Clus.SendUI("SEARCH", &progress)
+	}
+}
+
+// measureStep holds a step for c.Step, giving how the nodes did once
+// it had settled; false if the search was stopped.
+func measureStep(c SearchConfig, stop chan bool, nodes int) (step SearchStep, ok bool) {
+	w := newStepWatch()
+	searchMutex.Lock()
+	searchStep = w
+	searchMutex.Unlock()
+	defer func() {
+		searchMutex.Lock()
+		if searchStep == w {
+			searchStep = nil
+		}
+		searchMutex.Unlock()
+	}()
+	settled := time.After(c.Settle.Duration)
+	done := time.After(c.Step.Duration)
+	for {
+		select {
+		case <-stop:
+			return step, false
+		case <-settled:
+			settled = nil
+			searchMutex.Lock()
+			w.from = uint64(time.Now().Unix()+1) * 1000 // From the next whole second
+			searchMutex.Unlock()
+		case <-done:
+			until := uint64(time.Now().Unix()) * 1000 // Seconds every node has reported
+			searchMutex.Lock()
+			defer searchMutex.Unlock()
+			step.Nodes = nodes
+			var total uint64
+			seconds := 0
+			for ts, qps := range w.qps {
+				if ts < until {
+					total += qps
+					seconds++
+				}
+			}
+			if seconds > 0 {
+				step.ClusterQPS = float64(total) / float64(seconds)
+			}
+			if nodes > 0 {
+				step.QPS = step.ClusterQPS / float64(nodes)
+			}
+			hist := stats.NewHistogram()
+			for ts, h := range w.hists {
+				if ts < until {
+					hist.Merge(h)
+				}
+			}
+			step.Latency = hist.Percentiles()
+			var counts stats.Counts
+			for node, last := range w.last {
+				counts.Add(last.Sub(w.first[node]))
+			}
+			if counts.Attempted > 0 {
+				step.ErrorRate = float64(counts.TotalErrors()) / float64(counts.Attempted)
+			}
+			return step, true
+		}
+	}
+}
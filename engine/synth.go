@@ -0,0 +1,292 @@
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	. "github.com/lyfe-mobile/hitter/common"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// SynthConfig controls the shape of generated logs. Cardinalities
+// multiply out to the encoded ID key space, so raising Campaigns or
+// Sites is the way to get a much bigger working set than the
+// captured logs have.
+type SynthConfig struct {
+	Campaigns   int
+	Advertisers int
+	Sites       int
+	AdTags      int
+	Exchanges   int
+	Files       int // Per collection
+	Records     int // Per file
+
+	// Relative weights of the w/b/c/csl record types in the
+	// total_data, location_data and device_data logs.
+	Mix map[string]float64
+
+	// "fixed" (always SpendMean), "uniform" (SpendMin to SpendMax)
+	// or "exponential" (averaging SpendMean). Dollars per won
+	// impression.
+	SpendDist string
+	SpendMean float64
+	SpendMin  float64
+	SpendMax  float64
+
+	Start time.Time
+	End   time.Time
+	Seed  int64
+}
+
+func DefaultSynthConfig() SynthConfig {
+	end := time.Now().UTC().Truncate(24 * time.Hour)
+	return SynthConfig{
+		Campaigns:   100,
+		Advertisers: 10,
+		Sites:       1000,
+		AdTags:      50,
+		Exchanges:   5,
+		Files:       1,
+		Records:     1000,
+		Mix:         map[string]float64{WINS: 100, BIDS: 0, CLICKS: 1, CLIENT_SIDE_LOAD: 1},
+		SpendDist:   "fixed",
+		SpendMean:   0.03,
+		SpendMin:    0.01,
+		SpendMax:    0.10,
+		Start:       end.Add(-7 * 24 * time.Hour),
+		End:         end,
+		Seed:        1,
+	}
+}
+
+// LoadSynthConfig reads JSON settings from path on top of the
+// defaults. "defaults" just gives the defaults.
+func LoadSynthConfig(path string) (cfg SynthConfig, err error) {
+	cfg = DefaultSynthConfig()
+	if path == "defaults" {
+		return
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return
+	}
+	if cfg.Campaigns < 1 || cfg.Advertisers < 1 || cfg.Sites < 1 || cfg.AdTags < 1 || cfg.Exchanges < 1 {
+		err = fmt.Errorf("Synthetic cardinalities must all be at least 1")
+	} else if !cfg.End.After(cfg.Start) {
+		err = fmt.Errorf("Synthetic End date must be after Start")
+	}
+	return
+}
+
+// SynthSource generates logs on the fly instead of reading them. The
+// same name always generates the same records, so a replayed file
+// can be checked against the database like a captured one.
+type SynthSource struct {
+	Config    SynthConfig
+	campaigns []string
+	mixTypes  []string
+	mixTotal  float64
+}
+
+func NewSynthSource(cfg SynthConfig) *SynthSource {
+	s := &SynthSource{Config: cfg}
+	r := rand.New(rand.NewSource(cfg.Seed))
+	for i := 0; i < cfg.Campaigns; i++ {
+		id := make([]byte, 12)
+		r.Read(id)
+		s.campaigns = append(s.campaigns, fmt.Sprintf("%x", id))
+	}
+	for t, w := range cfg.Mix {
+		if w > 0 {
+			s.mixTypes = append(s.mixTypes, t)
+			s.mixTotal += w
+		}
+	}
+	sort.Strings(s.mixTypes) // Map order would make output random.
+	return s
+}
+
+func (s *SynthSource) String() string {
+	return fmt.Sprintf("synthetic:seed=%d", s.Config.Seed)
+}
+
+// Names gives the log names generated for coll, in the same
+// <coll>_static_<time> form as captured logs.
+func (s *SynthSource) Names(coll string) []string {
+	var names []string
+	for i := 0; i < s.Config.Files; i++ {
+		ts := s.Config.Start.Add(time.Duration(i) * LogRotation[coll])
+		names = append(names, filepath.Join(AggLogDir, coll+"_static_"+ts.Format(time.RFC3339)))
+	}
+	return names
+}
+
+func (s *SynthSource) Glob(pattern string) (matches []string, err error) {
+	file := filepath.Base(pattern)
+	for _, coll := range LogOrder {
+		for _, name := range s.Names(coll) {
+			var matched bool
+			if matched, err = filepath.Match(file, filepath.Base(name)); err != nil {
+				return
+			}
+			if matched {
+				matches = append(matches, name)
+			}
+		}
+	}
+	return
+}
+
+func (s *SynthSource) Open(name string) (io.ReadCloser, error) {
+	base := filepath.Base(name)
+	for _, coll := range LogOrder {
+		if strings.HasPrefix(base, coll+"_static_") {
+			var buf bytes.Buffer
+			if err := s.Generate(&buf, coll, name); err != nil {
+				return nil, err
+			}
+			return ioutil.NopCloser(&buf), nil
+		}
+	}
+	return nil, fmt.Errorf("No synthetic log named %s", name)
+}
+
+// Generate writes the records of the named log for coll to w.
+func (s *SynthSource) Generate(w io.Writer, coll, name string) error {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	r := rand.New(rand.NewSource(s.Config.Seed ^ int64(h.Sum64())))
+	bw := bufio.NewWriter(w)
+	for i := 0; i < s.Config.Records; i++ {
+		camp := r.Intn(len(s.campaigns))
+		switch coll {
+		case AdvertiserColl: // Spend in these two is per mille, like the captured logs.
+			fmt.Fprintf(bw, "%s %g\n", s.advertiser(camp), s.spend(r)*1000)
+		case CampaignColl:
+			fmt.Fprintf(bw, "%s %g\n", s.campaigns[camp], s.spend(r)*1000)
+		default:
+			fmt.Fprintf(bw, "%s %s\n", s.encodedID(r, coll, camp), s.record(r))
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteFiles writes every synthetic log out to dir, ready for -logdir.
+func (s *SynthSource) WriteFiles(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, coll := range LogOrder {
+		for _, name := range s.Names(coll) {
+			f, err := os.Create(filepath.Join(dir, filepath.Base(name)))
+			if err != nil {
+				return err
+			}
+			err = s.Generate(f, coll, name)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Seed makes sure the campaigns and advertisers the logs refer to
+// exist, since those collections are only ever updated.
+func (s *SynthSource) Seed(db *mgo.Database) error {
+	for i, camp := range s.campaigns {
+		if _, err := db.C(CampaignColl).Upsert(bson.M{"_id": bson.ObjectIdHex(camp)},
+			bson.M{"$set": bson.M{"advertiser": s.advertiser(i)}}); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < s.Config.Advertisers; i++ {
+		if _, err := db.C(AdvertiserColl).Upsert(bson.M{"username": s.advertiser(i)},
+			bson.M{"$setOnInsert": bson.M{"funds": 1000000.0}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Each campaign belongs to exactly one advertiser.
+func (s *SynthSource) advertiser(camp int) string {
+	return fmt.Sprintf("synth%d@example.com", camp%s.Config.Advertisers)
+}
+
+func (s *SynthSource) spend(r *rand.Rand) float64 {
+	switch s.Config.SpendDist {
+	case "uniform":
+		return s.Config.SpendMin + r.Float64()*(s.Config.SpendMax-s.Config.SpendMin)
+	case "exponential":
+		return r.ExpFloat64() * s.Config.SpendMean
+	}
+	return s.Config.SpendMean
+}
+
+// record gives the type field, plus spend for wins.
+func (s *SynthSource) record(r *rand.Rand) string {
+	pick := r.Float64() * s.mixTotal
+	for _, t := range s.mixTypes {
+		if pick -= s.Config.Mix[t]; pick < 0 {
+			if t == WINS {
+				return fmt.Sprintf("%s %f", t, s.spend(r))
+			}
+			return t
+		}
+	}
+	return fmt.Sprintf("%s %f", WINS, s.spend(r))
+}
+
+func (s *SynthSource) date(r *rand.Rand) string {
+	days := int(s.Config.End.Sub(s.Config.Start).Hours()/24) + 1
+	return s.Config.Start.Truncate(24 * time.Hour).Add(time.Duration(r.Intn(days)) * 24 * time.Hour).Format(time.RFC3339)
+}
+
+// encodedID builds an ID in the format UnpackEncodedID (total_data)
+// or DetailsLog (location_data, device_data) expects.
+func (s *SynthSource) encodedID(r *rand.Rand, coll string, camp int) string {
+	date := s.date(r)
+	exchange := r.Intn(s.Config.Exchanges) + 1
+	var raw []byte
+	switch coll {
+	case LocColl:
+		raw, _ = json.Marshal([]interface{}{s.campaigns[camp], s.advertiser(camp), date, exchange,
+			fmt.Sprintf("city%d", r.Intn(500)), fmt.Sprintf("st%d", r.Intn(50)), "us"})
+	case DeviceColl:
+		raw, _ = json.Marshal([]interface{}{s.campaigns[camp], s.advertiser(camp), date, exchange,
+			fmt.Sprintf("handset%d", r.Intn(100)), []string{"ANDROID", "iOS"}[r.Intn(2)],
+			r.Intn(10) * 100, fmt.Sprintf("310-%03d", r.Intn(1000))})
+	default:
+		raw = []byte(strings.Join([]string{
+			s.campaigns[camp],
+			s.advertiser(camp),
+			date,
+			url.QueryEscape(fmt.Sprintf("www.site%d.com", r.Intn(s.Config.Sites))),
+			url.QueryEscape(fmt.Sprintf("Ad Tag %d", r.Intn(s.Config.AdTags))),
+			fmt.Sprint(exchange),
+			[]string{"32", "50", "300x250", "320x50"}[r.Intn(4)],
+		}, "&"))
+	}
+	return base64.URLEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,23 @@
+// +build test
+
+package engine
+
+import "time"
+
+// HeadlessWaits sets how long headless runs wait to settle, at most
+// for nodes to switch DB target, and at most for replay counts. It
+// gives back what puts them back.
+func HeadlessWaits(settle, dbSwitch, replays time.Duration) (restore func()) {
+	was := []time.Duration{headlessSettle, headlessSwitch, headlessReplays}
+	headlessSettle, headlessSwitch, headlessReplays = settle, dbSwitch, replays
+	return func() {
+		headlessSettle, headlessSwitch, headlessReplays = was[0], was[1], was[2]
+	}
+}
+
+// MeasureSearchStep measures one search step of c across nodes nodes,
+// as a search would.
+func MeasureSearchStep(c SearchConfig, nodes int) SearchStep {
+	step, _ := measureStep(c, make(chan bool), nodes)
+	return step
+}
@@ -0,0 +1,392 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	. "github.com/lyfe-mobile/hitter/common"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ReplayCount is how many times a log has been replayed since the
+// last ResetReplays. Partial replays were cut short by a stop, a
+// collection being switched off or a procs change, so some but not
+// all of their writes went out.
+type ReplayCount struct {
+	Full    int `json:"full"`
+	Partial int `json:"partial"`
+}
+
+var (
+	replays      = map[string]*ReplayCount{}
+	replaysMutex sync.Mutex
+)
+
+func countReplay(log_path string, full bool) {
+	replaysMutex.Lock()
+	defer replaysMutex.Unlock()
+	count, ok := replays[log_path]
+	if !ok {
+		count = new(ReplayCount)
+		replays[log_path] = count
+	}
+	if full {
+		count.Full++
+	} else {
+		count.Partial++
+	}
+}
+
+// Replays gives a copy of this node's replay counts by log name.
+func Replays() map[string]ReplayCount {
+	replaysMutex.Lock()
+	defer replaysMutex.Unlock()
+	counts := make(map[string]ReplayCount, len(replays))
+	for name, count := range replays {
+		counts[name] = *count
+	}
+	return counts
+}
+
+func ResetReplays() {
+	replaysMutex.Lock()
+	replays = map[string]*ReplayCount{}
+	replaysMutex.Unlock()
+}
+
+// Document fields each log increments, by collection, and the key the
+// documents are found by.
+var verifyFields = map[string][]string{
+	TdColl:         {"impressions_won", CLIENT_SIDE_LOAD, "impressions_seen", "banner_clicks", "spend"},
+	LocColl:        {WINS, CLIENT_SIDE_LOAD, BIDS, CLICKS, SPEND},
+	DeviceColl:     {WINS, CLIENT_SIDE_LOAD, BIDS, CLICKS, SPEND},
+	CampaignColl:   {"imps", "spend"},
+	AdvertiserColl: {"funds"},
+}
+
+var verifyKeys = map[string]string{
+	TdColl:         "encoded_id",
+	LocColl:        "encoded_id",
+	DeviceColl:     "encoded_id",
+	CampaignColl:   "_id",
+	AdvertiserColl: "username",
+}
+
+// Increments maps document keys to the amount each field goes up by.
+type Increments map[string]map[string]float64
+
+func (inc Increments) add(key, field string, n float64) {
+	if _, ok := inc[key]; !ok {
+		inc[key] = make(map[string]float64)
+	}
+	inc[key][field] += n
+}
+
+// LogIncrements works out what one replay of a log does to its
+// collection, the same way the log functions do.
+func LogIncrements(coll, log_path string) Increments {
+	inc := Increments{}
+	switch coll {
+	case AdvertiserColl:
+		for advertiser, spend := range advertiserSpends(log_path) {
+			inc.add(advertiser, "funds", -spend)
+		}
+	case CampaignColl:
+		spends, imps := campaignTotals(log_path)
+		for campaign, spend := range spends {
+			if !bson.IsObjectIdHex(campaign) { // CampaignLog skips it
+				continue
+			}
+			inc.add(campaign, "spend", spend)
+			inc.add(campaign, "imps", float64(imps[campaign]))
+		}
+	default:
+		log_agg := make(map[string]map[string]float64)
+		AggregateLog(log_path, coll, makeAggregator(coll, log_agg))
+		fields := verifyFields[coll]
+		for encodedID, counts := range log_agg {
+			if _, err := setFields(coll, encodedID); err != nil { // The log function skips it
+				continue
+			}
+			for i, record_type := range []string{WINS, CLIENT_SIDE_LOAD, BIDS, CLICKS, SPEND} {
+				inc.add(encodedID, fields[i], counts[record_type])
+			}
+		}
+	}
+	return inc
+}
+
+// Baseline is what the documents the logs touch held before a run.
+// Documents that didn't exist aren't in it.
+type Baseline struct {
+	Time   time.Time             `json:"time"`
+	Values map[string]Increments `json:"values"` // By collection
+}
+
+func logNames(coll string) ([]string, error) {
+	return Glob(filepath.Join(AggLogDir, coll+"_static_*"))
+}
+
+// docKey gives the value to look a document up by.
+func docKey(coll, key string) interface{} {
+	if coll == CampaignColl {
+		if !bson.IsObjectIdHex(key) {
+			return key
+		}
+		return bson.ObjectIdHex(key)
+	}
+	return key
+}
+
+// fetchValues reads the verified fields of the documents with keys in
+// coll.
+func fetchValues(db *mgo.Database, coll string, keys []string) (Increments, error) {
+	values := Increments{}
+	keyField := verifyKeys[coll]
+	selector := bson.M{keyField: 1}
+	for _, field := range verifyFields[coll] {
+		selector[field] = 1
+	}
+	for start := 0; start < len(keys); start += 1000 {
+		end := start + 1000
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var ids []interface{}
+		for _, key := range keys[start:end] {
+			ids = append(ids, docKey(coll, key))
+		}
+		var docs []bson.M
+		if err := db.C(coll).Find(bson.M{keyField: bson.M{"$in": ids}}).Select(selector).All(&docs); err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			key := fmt.Sprint(doc[keyField])
+			if id, ok := doc[keyField].(bson.ObjectId); ok {
+				key = id.Hex()
+			}
+			values[key] = make(map[string]float64)
+			for _, field := range verifyFields[coll] {
+				values[key][field] = number(doc[field])
+			}
+		}
+	}
+	return values, nil
+}
+
+func number(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case int32:
+		return float64(n)
+	}
+	return 0
+}
+
+// allIncrements gives the increments of every log of every collection.
+func allIncrements() (map[string]map[string]Increments, error) {
+	all := map[string]map[string]Increments{}
+	for _, coll := range LogOrder {
+		names, err := logNames(coll)
+		if err != nil {
+			return nil, err
+		}
+		all[coll] = map[string]Increments{}
+		for _, name := range names {
+			all[coll][name] = LogIncrements(coll, name)
+		}
+	}
+	return all, nil
+}
+
+// TakeBaseline reads what the documents the logs touch hold now.
+// Take it with every node stopped.
+func TakeBaseline() (*Baseline, error) {
+	session := readSession()
+	if session == nil {
+		return nil, fmt.Errorf("Not connected to Mongo")
+	}
+	defer session.Close()
+	all, err := allIncrements()
+	if err != nil {
+		return nil, err
+	}
+	b := &Baseline{Time: time.Now(), Values: map[string]Increments{}}
+	for coll, logs := range all {
+		keys := map[string]bool{}
+		for _, inc := range logs {
+			for key := range inc {
+				keys[key] = true
+			}
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+		if b.Values[coll], err = fetchValues(session.DB(DBName()), coll, sorted); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Mismatch is a document field that isn't what the replays say it
+// should be. Partial replays give a range rather than one value.
+type Mismatch struct {
+	Key         string  `json:"key"`
+	Field       string  `json:"field,omitempty"`
+	Kind        string  `json:"kind"` // "missing", "low" (lost writes) or "high" (duplicated writes)
+	Expected    float64 `json:"expected"`
+	ExpectedMax float64 `json:"expectedmax"`
+	Actual      float64 `json:"actual"`
+}
+
+type CollectionReport struct {
+	Checked    int        `json:"checked"` // Documents
+	Matched    int        `json:"matched"`
+	Missing    int        `json:"missing"`
+	Low        int        `json:"low"`
+	High       int        `json:"high"`
+	Mismatches []Mismatch `json:"mismatches"`
+}
+
+type VerifyReport struct {
+	Time        time.Time                    `json:"time"`
+	Baseline    *time.Time                   `json:"baseline"` // Nil if there wasn't one
+	Replays     map[string]ReplayCount       `json:"replays"`  // Across the cluster, by log
+	Collections map[string]*CollectionReport `json:"collections"`
+}
+
+// OK is whether every document matched.
+func (r *VerifyReport) OK() bool {
+	for _, coll := range r.Collections {
+		if coll.Matched != coll.Checked {
+			return false
+		}
+	}
+	return true
+}
+
+// Spend adds up in floating point in a different order every time.
+const verifyTolerance = 1e-6
+
+func outside(actual, lo, hi float64) string {
+	slack := verifyTolerance * math.Max(1, math.Max(math.Abs(lo), math.Abs(hi)))
+	switch {
+	case actual < lo-slack:
+		return "low"
+	case actual > hi+slack:
+		return "high"
+	}
+	return ""
+}
+
+// Verify checks the database against baseline plus counts replays of
+// each log. Without a baseline, documents are taken to have started
+// out empty, which only holds for a fresh database.
+func Verify(baseline *Baseline, counts map[string]ReplayCount) (*VerifyReport, error) {
+	session := readSession()
+	if session == nil {
+		return nil, fmt.Errorf("Not connected to Mongo")
+	}
+	defer session.Close()
+	all, err := allIncrements()
+	if err != nil {
+		return nil, err
+	}
+	report := &VerifyReport{Time: time.Now(), Replays: counts, Collections: map[string]*CollectionReport{}}
+	if baseline != nil {
+		report.Baseline = &baseline.Time
+	}
+	for _, coll := range LogOrder {
+		lo, hi := Increments{}, Increments{}
+		for name, inc := range all[coll] {
+			count := counts[name]
+			if count.Full+count.Partial == 0 {
+				continue
+			}
+			for key, fields := range inc {
+				for field, n := range fields {
+					lo.add(key, field, n*float64(count.Full))
+					hi.add(key, field, n*float64(count.Full+count.Partial))
+				}
+			}
+		}
+		keys := make([]string, 0, len(lo))
+		for key := range lo {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		actual, err := fetchValues(session.DB(DBName()), coll, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		cr := &CollectionReport{Mismatches: []Mismatch{}}
+		report.Collections[coll] = cr
+		for _, key := range keys {
+			cr.Checked++
+			var base map[string]float64
+			if baseline != nil {
+				base = baseline.Values[coll][key]
+			}
+			doc, found := actual[key]
+			if !found {
+				cr.Missing++
+				cr.Mismatches = append(cr.Mismatches, Mismatch{Key: key, Kind: "missing"})
+				continue
+			}
+			matched := true
+			for _, field := range verifyFields[coll] {
+				a, b := base[field]+lo[key][field], base[field]+hi[key][field]
+				if a > b { // Funds go down
+					a, b = b, a
+				}
+				if kind := outside(doc[field], a, b); kind != "" {
+					if matched {
+						if kind == "low" {
+							cr.Low++
+						} else {
+							cr.High++
+						}
+					}
+					matched = false
+					cr.Mismatches = append(cr.Mismatches, Mismatch{
+						Key: key, Field: field, Kind: kind, Expected: a, ExpectedMax: b, Actual: doc[field],
+					})
+				}
+			}
+			if matched {
+				cr.Matched++
+			}
+		}
+		cluster.Log("Verified %s: %d of %d documents match", coll, cr.Matched, cr.Checked)
+	}
+	return report, nil
+}
+
+// SumReplays adds up replay counts from several nodes.
+func SumReplays(nodes ...map[string]ReplayCount) map[string]ReplayCount {
+	sum := map[string]ReplayCount{}
+	for _, counts := range nodes {
+		for name, count := range counts {
+			total := sum[name]
+			total.Full += count.Full
+			total.Partial += count.Partial
+			sum[name] = total
+		}
+	}
+	return sum
+}
@@ -1,11 +1,21 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
 	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strings"
 	"sync"
+	"syscall"
+	"time"
 
 	"github.com/lyfe-mobile/hitter/cluster"
 	"github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/ctl"
 	"github.com/lyfe-mobile/hitter/engine"
 	"github.com/lyfe-mobile/hitter/web"
 )
@@ -14,6 +24,11 @@ func Main() {
 	if err := engine.DialMongo(); err != nil {
 		panic(err)
 	}
+	if synth, ok := engine.Source.(*engine.SynthSource); ok {
+		if err := synth.Seed(engine.LiveDB.DB(common.DBName())); err != nil {
+			panic(err)
+		}
+	}
 
 	clus := cluster.NewCluster()
 	err := clus.Start()
@@ -26,33 +41,221 @@ func Main() {
 	cluster.Clus = clus
 	defer cluster.Clus.Stop()
 
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := clus.ReloadKeys(); err != nil {
+				cluster.Log("Reloading gossip keys: %s", err)
+			} else {
+				cluster.Log("Gossip keys now %s", strings.Join(clus.Keys(), ", "))
+			}
+		}
+	}()
+
 	go engine.MonitorQPS()
 
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		engine.Engine()
 	}()
 
+	if headless {
+		code := runHeadless()
+		clus.Stop()
+		os.Exit(code)
+	}
+
+	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		web.UI()
 	}()
 
+	if profile != nil {
+		clus.Settle(profileSettle) // For peers to become members and get the START
+		if err := engine.StartProfile(profile); err != nil {
+			panic(err)
+		}
+	}
+
 	wg.Wait()
 }
 
-var clusterhost *string
+var (
+	clusterhost *string
+	profile     *engine.Profile // Run at startup
+
+	// How long membership has to stay the same before the profile starts
+	profileSettle = 3 * time.Second
+
+	// hitter run: the profile with no UI, checked against thresholds
+	headless   bool
+	dbtarget   string // Every node switches to it first
+	thresholds engine.Thresholds
+	reportfile string
+)
+
+// runHeadless runs the profile for hitter run, writes the report, and
+// gives the exit code: 1 if a threshold wasn't met, 2 if the run
+// couldn't be made.
+func runHeadless() int {
+	report, err := engine.RunHeadless(profile, dbtarget, thresholds, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "hitter run: %s\n", err)
+		return 2
+	}
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err == nil {
+		err = ioutil.WriteFile(reportfile, b, 0644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "hitter run: writing the report: %s\n", err)
+		return 2
+	}
+	fmt.Printf("Profile %s on %s across %d nodes: mean QPS %.0f, peak %d, p99 %.1fms, error rate %.4f\n",
+		report.Profile, report.Target, len(report.Nodes), report.MeanQPS, report.PeakQPS, report.Latency.P99, report.ErrorRate)
+	for _, failure := range report.Failures {
+		fmt.Printf("FAIL: %s\n", failure)
+	}
+	if !report.Passed {
+		return 1
+	}
+	fmt.Println("PASS")
+	return 0
+}
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "ctl" { // Be a client instead
+		os.Exit(ctl.Main(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "run" { // A profile with no UI, for CI
+		headless = true
+		os.Args = append(os.Args[:1], os.Args[2:]...)
+	}
 	port := flag.Int("port", common.WEBPORT, "Port to listen for web requests")
 	clusterport := flag.Int("clusterport", 52001, "Port to listen for cluster")
 	clusterhost = flag.String("clusterhost", "", "Connect to this cluster host")
-	hn := flag.String("hostname", cluster.HostName, "Name to use for cluster")
+	hn := flag.String("hostname", "", "Name to use for cluster (default the EC2 public hostname with -discovery ec2, else the OS hostname)")
+	discovery := flag.String("discovery", "ec2", "Find other hitters with ec2, none, static:host[:port],..., file:<path>, dns:<name> or srv:<name>")
+	labels := flag.String("labels", "", "Labels for this node as key=value,..., to address commands to nodes by")
+	flag.StringVar(&cluster.KeysFile, "gossipkeys", "", "Encrypt gossip with the base64 keys in this file, one a line, the first to send with (default from $"+cluster.KeysEnv+", comma separated); SIGHUP rereads it")
+	genkey := flag.Bool("genkey", false, "Print a new gossip key and exit")
+	flag.StringVar(&cluster.BindAddr, "bindaddr", "", "Address to listen for cluster on (default the EC2 private IP with -discovery ec2, else all)")
+	flag.StringVar(&cluster.AdvertiseAddr, "advertiseaddr", "", "Address other hitters reach this one at (default the bind address, or with all, the first interface's)")
+	logdir := flag.String("logdir", "", "Replay <coll>_static_* logs from this directory instead of the embedded ones")
+	synth := flag.String("synth", "", `Replay synthetic logs generated with the JSON settings in this file ("defaults" for built-in settings)`)
+	synthout := flag.String("synthout", "", "Write the synthetic logs to this directory and exit")
+	writeconcern := flag.String("writeconcern", common.WRITECONCERN, "Write concern: w0, w1, majority or journaled, optionally with ,wtimeout=<ms>")
+	flag.IntVar(&engine.BulkSize, "bulksize", engine.BulkSize, "Upsert aggregated logs in Bulk batches of this many documents (0 for one at a time)")
+	bulkunordered := flag.Bool("bulkunordered", false, "Run Bulk batches unordered")
+	flag.BoolVar(&engine.BulkByBatch, "bulkbybatch", engine.BulkByBatch, "Count each Bulk batch as one operation against the QPS target instead of one per document")
+	flag.IntVar(&engine.READQPS, "readqps", engine.READQPS, "Dashboard queries per second to run alongside the writes")
+	flag.Float64Var(&engine.READRATIO, "readratio", engine.READRATIO, "Run this many dashboard queries per write instead of -readqps")
+	flag.IntVar(&engine.ReadProcs, "readprocs", engine.ReadProcs, "Dashboard queries that can be waiting on Mongo at once")
+	guardrails := flag.String("guardrails", "", "Stop every node when one of these is breached, as p99=<ms>,errorrate=<fraction>,lag=<seconds>,funds=<least>,for=<seconds>")
+	flag.StringVar(&engine.RunDir, "rundir", engine.RunDir, "Keep a JSON record of each run in this directory (empty for none)")
+	dbtargets := flag.String("dbtargets", "", "Load the DB targets from this JSON file, saving changes made at runtime back to it")
+	usersfile := flag.String("users", "", "Require logins or tokens from the users in this JSON file (default anyone may do anything)")
+	hashpassword := flag.Bool("hashpassword", false, "Print the bcrypt hash of the password on stdin, for -users, and exit")
+	profilefile := flag.String("profile", "", "Run the load profile in this JSON file across the cluster at startup")
+	if headless {
+		flag.StringVar(&dbtarget, "target", "", "DB target to run against (default "+common.WHICHDB+", or the first of -dbtargets)")
+		flag.Float64Var(&thresholds.MinQPS, "minqps", 0, "Fail if the cluster's mean QPS over the run is under this")
+		flag.Float64Var(&thresholds.MaxP99, "maxp99", 0, "Fail if the cluster's p99 latency over the run is over this many milliseconds")
+		flag.Float64Var(&thresholds.MaxErrorRate, "maxerrorrate", 0, "Fail if more than this fraction of attempted operations err")
+		flag.BoolVar(&thresholds.Verify, "verify", false, "Take a baseline first and fail if the database doesn't match the replays after")
+		flag.StringVar(&reportfile, "report", "report.json", "Write the run report to this JSON file")
+	}
 	flag.Parse()
+	if *genkey {
+		key, err := cluster.GenerateKey()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(key)
+		return
+	}
+	if *hashpassword {
+		password, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		hash, err := web.HashPassword(strings.TrimRight(password, "\r\n"))
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(hash)
+		return
+	}
+	if *usersfile != "" {
+		if err := web.LoadUsers(*usersfile); err != nil {
+			panic(err)
+		}
+	}
+	engine.BulkOrdered = !*bulkunordered
+	if err := engine.SetGuardrails(strings.FieldsFunc(*guardrails, func(r rune) bool { return r == ',' })); err != nil {
+		panic(err)
+	}
+	if _, err := common.ParseWriteConcern(*writeconcern); err != nil {
+		panic(err)
+	}
+	common.WRITECONCERN = *writeconcern
+	if *dbtargets != "" {
+		if err := common.LoadTargets(*dbtargets); err != nil {
+			panic(err)
+		}
+		if _, ok := common.Target(common.WHICHDB); !ok {
+			common.WHICHDB = common.Targets().Targets[0].Name
+		}
+	}
+	var err error
+	if cluster.Labels, err = cluster.ParseLabels(*labels); err != nil {
+		panic(err)
+	}
 	cluster.ClusterPort = *clusterport
 	common.WEBPORT = *port
-	cluster.HostName = *hn
+	if cluster.Discover, err = cluster.ParseDiscovery(*discovery); err != nil {
+		panic(err)
+	}
+	if *hn != "" {
+		cluster.HostName = *hn
+	} else if _, ok := cluster.Discover.(cluster.EC2Discovery); ok {
+		if cluster.HostName, err = cluster.GetAWSHostname(); err != nil {
+			panic(err)
+		}
+	}
+	engine.UseLogDir(*logdir)
+	if *synth != "" || *synthout != "" {
+		if *synth == "" {
+			*synth = "defaults"
+		}
+		cfg, err := engine.LoadSynthConfig(*synth)
+		if err != nil {
+			panic(err)
+		}
+		src := engine.NewSynthSource(cfg)
+		if *synthout != "" {
+			if err := src.WriteFiles(*synthout); err != nil {
+				panic(err)
+			}
+			return
+		}
+		engine.Source = src
+	}
+	if *profilefile != "" {
+		if profile, err = engine.LoadProfile(*profilefile); err != nil {
+			panic(err)
+		}
+	}
+	if headless {
+		if profile == nil {
+			fmt.Fprintln(os.Stderr, "hitter run needs a -profile")
+			os.Exit(2)
+		}
+		if _, ok := common.Target(dbtarget); dbtarget != "" && !ok {
+			fmt.Fprintf(os.Stderr, "hitter run: no DB target %q\n", dbtarget)
+			os.Exit(2)
+		}
+	}
 	Main()
 }
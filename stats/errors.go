@@ -0,0 +1,184 @@
+package stats
+
+import (
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+
+	mgo "gopkg.in/mgo.v2"
+)
+
+// Classes of Mongo write errors.
+const (
+	Timeout      = "timeout"
+	DuplicateKey = "duplicate_key"
+	Network      = "network"
+	NotMaster    = "not_master"
+	WriteConcern = "write_concern"
+	Auth         = "auth"
+	Other        = "other"
+)
+
+var ErrorClasses = []string{Timeout, DuplicateKey, Network, NotMaster, WriteConcern, Auth, Other}
+
+// Server error codes, from the server's error_codes.err.
+var codeClasses = map[int]string{
+	11000: DuplicateKey,
+	11001: DuplicateKey,
+	12582: DuplicateKey,
+	64:    WriteConcern, // WriteConcernFailed
+	79:    WriteConcern, // UnknownReplWriteConcern
+	100:   WriteConcern, // UnsatisfiableWriteConcern
+	10107: NotMaster,
+	13435: NotMaster,
+	13436: NotMaster,
+	11600: NotMaster, // InterruptedAtShutdown
+	11602: NotMaster, // InterruptedDueToReplStateChange
+	189:   NotMaster, // PrimarySteppedDown
+	91:    NotMaster, // ShutdownInProgress
+	13:    Auth,      // Unauthorized
+	18:    Auth,      // AuthenticationFailed
+	50:    Timeout,   // ExceededTimeLimit
+}
+
+// Classify sorts an error from mgo into one of the ErrorClasses.
+func Classify(err error) string {
+	if err == nil {
+		return ""
+	}
+	if mgo.IsDup(err) {
+		return DuplicateKey
+	}
+	switch e := err.(type) {
+	case *mgo.LastError:
+		if e.WTimeout {
+			return WriteConcern
+		}
+		if class, ok := codeClasses[e.Code]; ok {
+			return class
+		}
+	case *mgo.QueryError:
+		if class, ok := codeClasses[e.Code]; ok {
+			return class
+		}
+	case net.Error:
+		if e.Timeout() {
+			return Timeout
+		}
+		return Network
+	}
+	if err == io.EOF {
+		return Network
+	}
+	// mgo wraps a lot of these up as plain strings.
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "timeout") || strings.Contains(msg, "timed out"):
+		return Timeout
+	case strings.Contains(msg, "not master") || strings.Contains(msg, "node is recovering"):
+		return NotMaster
+	case strings.Contains(msg, "auth") || strings.Contains(msg, "unauthorized"):
+		return Auth
+	case strings.Contains(msg, "write concern") || strings.Contains(msg, "waiting for replication"):
+		return WriteConcern
+	case strings.Contains(msg, "no reachable servers") || strings.Contains(msg, "connection") ||
+		strings.Contains(msg, "broken pipe") || strings.Contains(msg, "closed explicitly") ||
+		strings.Contains(msg, "eof"):
+		return Network
+	}
+	return Other
+}
+
+// Counters keeps running totals of what happened to Mongo operations.
+// Every error is counted against its class, including ones that were
+// then retried; Failed only counts operations that were given up on.
+type Counters struct {
+	attempted uint64
+	succeeded uint64
+	failed    uint64
+	retried   uint64
+	errors    map[string]*uint64
+}
+
+func NewCounters() *Counters {
+	c := &Counters{errors: make(map[string]*uint64)}
+	for _, class := range ErrorClasses {
+		c.errors[class] = new(uint64)
+	}
+	return c
+}
+
+func (c *Counters) Attempt() { atomic.AddUint64(&c.attempted, 1) }
+func (c *Counters) Succeed() { atomic.AddUint64(&c.succeeded, 1) }
+func (c *Counters) Fail()    { atomic.AddUint64(&c.failed, 1) }
+func (c *Counters) Retry()   { atomic.AddUint64(&c.retried, 1) }
+
+// Error counts err against its class and returns the class.
+func (c *Counters) Error(err error) string {
+	class := Classify(err)
+	atomic.AddUint64(c.errors[class], 1)
+	return class
+}
+
+// Counts is a copy of Counters at one point in time, as sent around
+// the cluster.
+type Counts struct {
+	Attempted uint64            `json:"attempted"`
+	Succeeded uint64            `json:"succeeded"`
+	Failed    uint64            `json:"failed"`
+	Retried   uint64            `json:"retried"`
+	Errors    map[string]uint64 `json:"errors"`
+}
+
+func (c *Counters) Counts() Counts {
+	counts := Counts{
+		Attempted: atomic.LoadUint64(&c.attempted),
+		Succeeded: atomic.LoadUint64(&c.succeeded),
+		Failed:    atomic.LoadUint64(&c.failed),
+		Retried:   atomic.LoadUint64(&c.retried),
+		Errors:    make(map[string]uint64),
+	}
+	for class, n := range c.errors {
+		counts.Errors[class] = atomic.LoadUint64(n)
+	}
+	return counts
+}
+
+// Add sums o into c, for cluster totals.
+func (c *Counts) Add(o Counts) {
+	c.Attempted += o.Attempted
+	c.Succeeded += o.Succeeded
+	c.Failed += o.Failed
+	c.Retried += o.Retried
+	if c.Errors == nil {
+		c.Errors = make(map[string]uint64)
+	}
+	for class, n := range o.Errors {
+		c.Errors[class] += n
+	}
+}
+
+// Sub gives what happened between o and c, for a run's share of the
+// running totals.
+func (c Counts) Sub(o Counts) Counts {
+	diff := Counts{
+		Attempted: c.Attempted - o.Attempted,
+		Succeeded: c.Succeeded - o.Succeeded,
+		Failed:    c.Failed - o.Failed,
+		Retried:   c.Retried - o.Retried,
+		Errors:    make(map[string]uint64),
+	}
+	for class, n := range c.Errors {
+		diff.Errors[class] = n - o.Errors[class]
+	}
+	return diff
+}
+
+// TotalErrors is the number of errors of any class.
+func (c Counts) TotalErrors() (total uint64) {
+	for _, n := range c.Errors {
+		total += n
+	}
+	return
+}
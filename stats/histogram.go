@@ -0,0 +1,160 @@
+package stats
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Each bucket is this much wider than the one before it, so any
+// percentile read back is within about 5% of the real value.
+const bucketGrowth = 1.05
+
+var logGrowth = math.Log(bucketGrowth)
+
+// Histogram counts latencies (in microseconds) in logarithmic
+// buckets. Histograms from different nodes or seconds merge by adding
+// up their buckets, which is what makes cluster-wide percentiles
+// possible. It marshals to compact JSON for the cluster messages.
+type Histogram struct {
+	Counts map[int]uint64 `json:"c"`
+	Total  uint64         `json:"n"`
+	Sum    int64          `json:"s"`
+	Max    int64          `json:"m"`
+}
+
+func NewHistogram() *Histogram {
+	return &Histogram{Counts: make(map[int]uint64)}
+}
+
+func bucket(us int64) int {
+	if us < 1 {
+		return 0
+	}
+	return int(math.Log(float64(us)) / logGrowth)
+}
+
+// Top of the bucket, in microseconds.
+func bucketValue(b int) int64 {
+	return int64(math.Ceil(math.Exp(float64(b+1) * logGrowth)))
+}
+
+func (h *Histogram) Record(d time.Duration) {
+	us := int64(d / time.Microsecond)
+	h.Counts[bucket(us)]++
+	h.Total++
+	h.Sum += us
+	if us > h.Max {
+		h.Max = us
+	}
+}
+
+// Merge adds o's counts into h.
+func (h *Histogram) Merge(o *Histogram) {
+	if o == nil {
+		return
+	}
+	for b, n := range o.Counts {
+		h.Counts[b] += n
+	}
+	h.Total += o.Total
+	h.Sum += o.Sum
+	if o.Max > h.Max {
+		h.Max = o.Max
+	}
+}
+
+// Quantile gives the latency at or below which q (0 to 1) of the
+// recorded operations fell.
+func (h *Histogram) Quantile(q float64) time.Duration {
+	if h.Total == 0 {
+		return 0
+	}
+	buckets := make([]int, 0, len(h.Counts))
+	for b := range h.Counts {
+		buckets = append(buckets, b)
+	}
+	sort.Ints(buckets)
+	want := uint64(math.Ceil(q * float64(h.Total)))
+	var seen uint64
+	for _, b := range buckets {
+		seen += h.Counts[b]
+		if seen >= want {
+			v := bucketValue(b)
+			if v > h.Max {
+				v = h.Max
+			}
+			return time.Duration(v) * time.Microsecond
+		}
+	}
+	return time.Duration(h.Max) * time.Microsecond
+}
+
+// CountBelow gives how many operations took d or less, to within a
+// bucket.
+func (h *Histogram) CountBelow(d time.Duration) (n uint64) {
+	limit := bucket(int64(d / time.Microsecond))
+	for b, count := range h.Counts {
+		if b <= limit {
+			n += count
+		}
+	}
+	return
+}
+
+// Copy gives an independent copy of h.
+func (h *Histogram) Copy() *Histogram {
+	c := NewHistogram()
+	c.Merge(h)
+	return c
+}
+
+// Percentiles is what gets charted, all in milliseconds.
+type Percentiles struct {
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P99  float64 `json:"p99"`
+	P999 float64 `json:"p999"`
+	Max  float64 `json:"max"`
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func (h *Histogram) Percentiles() Percentiles {
+	return Percentiles{
+		P50:  ms(h.Quantile(0.5)),
+		P90:  ms(h.Quantile(0.9)),
+		P99:  ms(h.Quantile(0.99)),
+		P999: ms(h.Quantile(0.999)),
+		Max:  ms(time.Duration(h.Max) * time.Microsecond),
+	}
+}
+
+// Recorder is a Histogram that many goroutines can record into while
+// another one periodically takes it away.
+type Recorder struct {
+	mutex sync.Mutex
+	hist  *Histogram
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{hist: NewHistogram()}
+}
+
+func (r *Recorder) Record(d time.Duration) {
+	r.mutex.Lock()
+	r.hist.Record(d)
+	r.mutex.Unlock()
+}
+
+// Snapshot returns everything recorded since the last Snapshot.
+func (r *Recorder) Snapshot() *Histogram {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	h := r.hist
+	r.hist = NewHistogram()
+	return h
+}
@@ -0,0 +1,95 @@
+package stats_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/lyfe-mobile/hitter/stats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	mgo "gopkg.in/mgo.v2"
+)
+
+var _ = Describe("Histogram", func() {
+	It("gives percentiles within a bucket's width", func() {
+		h := NewHistogram()
+		for i := 1; i <= 1000; i++ {
+			h.Record(time.Duration(i) * time.Millisecond)
+		}
+		Ω(h.Total).Should(BeNumerically("==", 1000))
+		Ω(h.Quantile(0.5)).Should(BeNumerically("~", 500*time.Millisecond, 25*time.Millisecond))
+		Ω(h.Quantile(0.99)).Should(BeNumerically("~", 990*time.Millisecond, 50*time.Millisecond))
+		Ω(h.Quantile(1)).Should(Equal(time.Second))
+	})
+	It("merges across nodes", func() {
+		fast, slow := NewHistogram(), NewHistogram()
+		for i := 0; i < 90; i++ {
+			fast.Record(time.Millisecond)
+		}
+		for i := 0; i < 10; i++ {
+			slow.Record(time.Second)
+		}
+		var decoded Histogram
+		b, err := json.Marshal(slow)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(json.Unmarshal(b, &decoded)).Should(Succeed())
+		fast.Merge(&decoded)
+		Ω(fast.Total).Should(BeNumerically("==", 100))
+		Ω(fast.Quantile(0.5)).Should(BeNumerically("~", time.Millisecond, 100*time.Microsecond))
+		Ω(fast.Percentiles().Max).Should(BeNumerically("==", 1000))
+	})
+	It("counts operations under a limit", func() {
+		h := NewHistogram()
+		for i := 1; i <= 100; i++ {
+			h.Record(time.Duration(i) * time.Millisecond)
+		}
+		Ω(h.CountBelow(50 * time.Millisecond)).Should(BeNumerically("~", 50, 3))
+		Ω(h.CountBelow(time.Second)).Should(BeNumerically("==", 100))
+		Ω(h.Sum).Should(BeNumerically("==", 5050000))
+	})
+	It("starts over after a snapshot", func() {
+		r := NewRecorder()
+		r.Record(time.Millisecond)
+		Ω(r.Snapshot().Total).Should(BeNumerically("==", 1))
+		Ω(r.Snapshot().Total).Should(BeNumerically("==", 0))
+	})
+})
+
+var _ = Describe("Errors", func() {
+	It("classifies Mongo errors", func() {
+		Ω(Classify(&mgo.LastError{Code: 11000, Err: "E11000 duplicate key error"})).Should(Equal(DuplicateKey))
+		Ω(Classify(&mgo.LastError{WTimeout: true, Err: "waiting for replication timed out"})).Should(Equal(WriteConcern))
+		Ω(Classify(&mgo.QueryError{Code: 10107, Message: "not master"})).Should(Equal(NotMaster))
+		Ω(Classify(&mgo.QueryError{Code: 18, Message: "auth failed"})).Should(Equal(Auth))
+		Ω(Classify(errors.New("read tcp 10.0.0.1:27017: i/o timeout"))).Should(Equal(Timeout))
+		Ω(Classify(errors.New("no reachable servers"))).Should(Equal(Network))
+		Ω(Classify(errors.New("something else"))).Should(Equal(Other))
+	})
+	It("keeps running totals", func() {
+		c := NewCounters()
+		c.Attempt()
+		c.Succeed()
+		c.Attempt()
+		Ω(c.Error(errors.New("no reachable servers"))).Should(Equal(Network))
+		c.Fail()
+		counts := c.Counts()
+		Ω(counts.Attempted).Should(BeNumerically("==", 2))
+		Ω(counts.Failed).Should(BeNumerically("==", 1))
+		Ω(counts.Errors[Network]).Should(BeNumerically("==", 1))
+		var total Counts
+		total.Add(counts)
+		total.Add(counts)
+		Ω(total.TotalErrors()).Should(BeNumerically("==", 2))
+		diff := total.Sub(counts)
+		Ω(diff.Attempted).Should(BeNumerically("==", 2))
+		Ω(diff.Errors[Network]).Should(BeNumerically("==", 1))
+	})
+})
+
+// Ginkgo boilerplate, this runs all tests in this package
+func TestSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Stats Tests")
+}
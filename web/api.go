@@ -0,0 +1,163 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/engine"
+)
+
+// API is the JSON REST API, for driving the cluster without the UI:
+//
+//	GET  /api/v1/cluster                  what /state/ gives
+//	GET  /api/v1/nodes                    every node
+//	GET  /api/v1/nodes/<name>             one node
+//	GET  /api/v1/runs                     this node's saved runs
+//	GET  /api/v1/runs/<id>                one of them
+//	POST /api/v1/commands                 a command, as {"type", "target", "payload"}
+//	POST /api/v1/nodes/<address>/<type>   the same, the body the payload
+//
+// Commands are the messages the UI sends over /ws, to the nodes the
+// target addresses (all of them if it's left out). The answer says how
+// each node took the command: done, refused (with the error), failed
+// (never acked), or acked but not done in time.
+func API(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1"), "/"), "/")
+	switch {
+	case r.Method == "GET" && len(parts) == 1 && parts[0] == "cluster":
+		writeJSON(w, clusterState())
+	case r.Method == "GET" && parts[0] == "nodes" && len(parts) <= 2:
+		var nodes []map[string]interface{}
+		for _, member := range cluster.Clus.Members.Members() {
+			if len(parts) == 1 || member.Name == parts[1] {
+				nodes = append(nodes, cluster.Clus.NewNode(member))
+			}
+		}
+		switch {
+		case len(parts) == 1:
+			writeJSON(w, nodes)
+		case len(nodes) == 1:
+			writeJSON(w, nodes[0])
+		default:
+			apiError(w, http.StatusNotFound, "No node "+parts[1])
+		}
+	case r.Method == "GET" && parts[0] == "runs" && len(parts) == 1:
+		runs, err := engine.Runs()
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, runs)
+	case r.Method == "GET" && parts[0] == "runs" && len(parts) == 2:
+		run, err := engine.LoadRun(parts[1])
+		if os.IsNotExist(err) {
+			apiError(w, http.StatusNotFound, "No run "+parts[1])
+			return
+		}
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, run)
+	case r.Method == "POST" && len(parts) == 1 && parts[0] == "commands":
+		var cmd struct {
+			Type    string          `json:"type"`
+			Target  string          `json:"target"`
+			Payload json.RawMessage `json:"payload"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+			apiError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		command(w, r, cmd.Target, cmd.Type, cmd.Payload)
+	case r.Method == "POST" && parts[0] == "nodes" && len(parts) == 3:
+		var payload json.RawMessage
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				apiError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		command(w, r, parts[1], strings.ToUpper(parts[2]), payload)
+	case r.Method != "GET" && r.Method != "POST":
+		apiError(w, http.StatusMethodNotAllowed, "GET or POST only")
+	default:
+		apiError(w, http.StatusNotFound, "No such API")
+	}
+}
+
+func apiError(w http.ResponseWriter, status int, err string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	b, _ := json.Marshal(map[string]string{"error": err})
+	w.Write(b)
+}
+
+// CommandResult is how the nodes took a command.
+type CommandResult struct {
+	ID     string                          `json:"id"`
+	Type   string                          `json:"type"`
+	Target string                          `json:"target"`
+	OK     bool                            `json:"ok"` // Every node did it
+	Nodes  map[string]cluster.NodeDelivery `json:"nodes"`
+}
+
+// How long past the last try to wait for nodes to act on a command.
+var handleWait = 5 * time.Second
+
+// command sends a command from whoever made r, as the UI would, and
+// reports how each node took it.
+func command(w http.ResponseWriter, r *http.Request, target, typ string, payload json.RawMessage) {
+	if err := cluster.Allowed(RoleOf(r), typ); err != nil {
+		apiError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	var args []interface{}
+	if len(payload) > 0 {
+		args = append(args, payload)
+	}
+	msg, err := cluster.NewMessage(cluster.Clus.Name, target, typ, args...)
+	if err != nil {
+		apiError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	result, err := RunCommand(msg)
+	if err != nil {
+		apiError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, result)
+}
+
+// RunCommand sends msg, a control message, and waits for each node to
+// act on it, refuse it or fail to get it.
+func RunCommand(msg *cluster.Message) (*CommandResult, error) {
+	if !cluster.IsControl(msg.Type) {
+		return nil, fmt.Errorf("%s isn't a command", msg.Type)
+	}
+	if err := cluster.Clus.Send(msg); err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(cluster.AckTimeout*time.Duration(cluster.DeliveryTries) + handleWait)
+	d, ok := cluster.Clus.Delivery(msg.ID)
+	for ok && !d.Handled() && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+		d, ok = cluster.Clus.Delivery(msg.ID)
+	}
+	if !ok {
+		return nil, fmt.Errorf("Lost track of %s %s", msg.Type, msg.ID)
+	}
+	result := &CommandResult{ID: msg.ID, Type: msg.Type, Target: msg.Target, OK: true, Nodes: d.Nodes}
+	if result.Target == "" {
+		result.Target = "*"
+	}
+	for _, n := range result.Nodes {
+		result.OK = result.OK && n.State == "done"
+	}
+	return result, nil
+}
@@ -74,7 +74,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\x5a\x7b\x73\xdb\x36\x12\xff\xbf\x9f\x02\xe1\xcd\x5c\x9c\xb9\x92\x74\xe2\x24\x76\x53\x49\x33\x89\x9d\x4b\xd3\xb8\x91\x62\xab\x6d\x7a\x9d\xce\x0d\x44\xac\x48\xd8\x20\xc0\x00\xa0\x2c\x55\xa3\xfb\xec\x37\x00\x1f\xa2\x24\x50\x96\x62\xbb\x73\xe7\x7f\x4c\xe2\xb1\xcf\xdf\x02\xbb\x2b\x76\x1e\x11\x11\xe9\x59\x06\x28\xd1\x29\xeb\x7d\xd3\x29\xfe\x21\xd4\x49\x00\x13\xf3\x80\x50\x27\x05\x8d\x51\x94\x60\xa9\x40\x77\xbd\x5c\x8f\xfd\x13\xaf\x9c\xd2\x54\x33\xe8\xfd\x24\x78\x2c\xd0\xb9\xc0\x04\x0d\x41\x69\x90\x9d\xb0\x98\x68\xec\xe7\x38\x85\xae\x37\xa1\x70\x93\x09\xa9\x3d\x14\x09\xae\x81\xeb\xae\x77\x43\x89\x4e\xba\x04\x26\x34\x02\xdf\xbe\x7c\x8b\x28\xa7\x9a\x62\xe6\xab\x08\x33\xe8\x3e\x0d\x0e\xbd\x4d\x52\x04\x54\x24\x69\xa6\xa9\xe0\x0d\x6a\x8e\x85\x38\xd7\x89\x90\x8d\x35\xaf\x19\x03\x8e\xce\xf3\x08\xaa\xd5\x8c\xf2\x6b\x24\x81\x75\x3d\x95\x08\xa9\xa3\x5c\x23\x1a\x19\xba\x89\x84\x71\xd7\xc3\x4a\x81\x56\x21\x8d\x44\x38\xc6\x13\x33\x13\xd0\x48\x78\x48\xd1\x3f\x41\x75\xbd\x67\x2f\x5e\x4e\x9f\xbd\x78\xe9\xf5\xbe\x29\xa8\x15\x72\x21\x25\xa3\xae\x17\x86\x91\x20\x10\x5c\x7d\xc9\x41\xce\x82\x48\xa4\x61\xf1\xe8\x33\xac\x41\xe9\xe0\x4a\x79\xbd\x4e\x58\xec\xd8\x14\x46\xcf\x18\xa8\x04\x40\x57\x92\x84\x61\x8a\xa7\x11\xe1\xc1\x48\x08\xad\xb4\xc4\x99\x79\x31\x64\xeb\x81\xf0\x28\x38\x0a\x8e\xc3\x48\xa9\xe5\x58\x90\x52\x1e\x44\x4a\x79\x96\x43\xf1\x47\xb9\x86\x58\x52\x3d\x33\x4a\xe3\xa3\x93\xe7\xfe\x9b\x5f\x7e\xa3\xf4\xf2\xfd\x3f\xe1\xc3\x53\xf2\x2e\xfd\xf1\xe2\xf5\xf5\x2c\xca\x7f\x78\xfd\xc3\x45\x7c\xf4\xac\x9f\xfe\x1c\xdd\xdc\x1c\x0b\x7e\x74\xf1\x1b\x89\x9f\xff\x82\xff\x31\x48\x2f\x87\xea\xcf\xf0\xc3\xcb\x93\xc9\x88\xbc\xbd\x4a\x9e\xe7\x4d\xea\x91\x14\x4a\x09\x49\x63\xca\xbb\x1e\xe6\x82\xcf\x52\x91\xab\xca\xde\xab\x16\xda\x55\xa5\xab\x75\x8d\xae\x56\x14\x72\xa9\x34\x8c\x5e\xbc\xff\x44\x47\x87\xcf\x8e\xbf\x4c\x66\x57\x97\x3f\x8d\x7f\xb8\xea\xff\x84\xcf\xaf\xc7\xf9\xaf\xbf\x4c\xff\x35\xfd\x79\xc0\x4f\x7f\x7c\x7d\xcc\x9e\xa5\xa7\xbf\x7e\x7c\x9f\xbd\xfb\x2e\x7d\x77\x7a\x76\x72\xf3\xee\xe3\xfb\x68\x70\x76\x3c\x9c\xe2\x55\xfa\x6d\x4a\x2d\x1d\xd8\xf0\xe0\x0a\x74\x8c\x37\x28\x27\x30\xb5\x5e\xd8\xf0\x6e\x8d\x1c\x33\x84\x4c\x3c\x76\x3d\x0d\x53\x6d\xf6\x95\x36\x43\x23\x41\x66\x68\x5e\xc9\x93\x61\x42\x28\x8f\x7d\x2d\xb2\x57\xe8\xe5\x61\x36\xfd\xbe\x98\x59\x14\x84\x42\x4b\xa9\x22\xfb\xc8\xf7\x7f\xa7\x63\xc4\x34\x7a\xff\x16\x7d\xf7\x47\x49\x70\xd5\x0d\x89\xd6\xd9\xab\x30\x34\xf1\xff\x42\x25\x34\x0d\x62\x21\x62\x06\x16\xbd\xc6\x19\x6a\xc2\x43\x2d\x73\x7e\x5d\x2c\x71\x01\xf7\xd1\xef\xc0\x09\x1d\xff\xe1\xfb\x0e\x43\x84\x61\x44\xf8\x95\x0a\x22\x26\x72\x32\x66\x58\x16\x64\xf1\x15\x9e\x86\x8c\x8e\x54\x38\x16\x5c\xfb\xf8\x06\x94\x48\x21\x7c\x1e\x1c\x07\x87\xd6\x6a\xcd\xe1\x1a\xc6\x9b\xe1\xe1\xb4\xd9\x7a\x24\x6e\x17\xa0\x8c\x51\xaa\x84\x16\x19\x84\x47\xc1\x61\xf0\x34\x2c\xdf\x82\xec\x3a\x26\x15\xe6\xd6\xf5\xde\x8f\x8b\x92\xc0\x09\xc8\xf0\x30\xf8\x2e\x38\x39\xaa\xdf\x1d\xc4\x37\xa9\x97\x68\xba\xaa\xc0\xb4\x2e\x4c\x27\xac\x8e\xed\x8e\x81\x4b\x29\x1f\xa1\x13\x14\x31\xac\x54\xd7\x8b\x25\x25\x1e\xa2\xa4\xeb\x8d\x98\x88\xae\xcf\xa9\xd2\x5e\x0d\x87\x47\xbe\x8f\x4e\xfb\x1f\x87\x17\xfd\x73\xf4\xe6\xbc\x7f\xfa\x01\x19\x4f\x96\x93\x6b\x44\x7c\xaa\x21\xad\xb7\xb6\xcc\xfb\xe5\x91\x8b\x08\x56\x89\x9f\x73\xaa\xfd\x91\x90\x04\x64\x63\xe3\xea\xd6\x7a\xa1\x3d\xad\x31\xe5\x20\x8b\xcd\x44\xe4\x23\x06\x23\x1a\xaf\x6c\x5d\xdd\x2c\xc5\xcd\xda\xac\x99\xb7\x37\x51\xb5\x24\x12\xcc\x9f\x2a\xff\xe9\xb3\x8d\x85\xc6\xd2\x19\xe6\xbd\x53\x96\x17\x37\x98\x7d\x73\x2f\xb2\x06\x94\x60\x6f\x88\x92\x70\x42\x09\x01\xee\xf5\x2e\xcc\x28\x87\x48\x53\x1e\x07\x41\x70\x3b\x19\x2e\x08\x44\x22\xe7\xba\x26\x95\xe5\x8c\xf9\x92\xc6\x89\xb6\xce\x75\xec\xef\x84\xa4\x71\xbf\x36\x46\xe9\x64\x6d\x28\x91\xfb\x9a\x6b\x39\xbf\xb4\x95\x15\xd4\x24\x00\xda\x4f\x31\xe5\x46\xac\x4d\x56\x8e\xa1\xaf\x61\x86\x4c\x0c\xfb\x11\x70\xbd\x06\x93\x72\x4f\x66\x85\xf9\x92\x29\x2d\x34\x66\x5e\xaf\x33\x12\x8c\xf4\x3a\xa1\xfd\xf7\x77\x3e\x52\xd9\xf7\x1d\x71\xdd\xfb\x92\xa9\x4e\x28\xae\x7b\x9d\x30\x73\x58\xef\x61\x84\x3f\x71\xc9\x3b\xca\xb5\x16\xbc\x3c\x9f\x8a\x97\x32\x00\xed\xf3\xbf\x33\x86\x67\xb5\xeb\x47\x9a\x7b\x48\xf0\x88\xd1\xe8\xba\xfb\x58\x03\x63\x6f\x27\x20\x67\x82\xc3\x81\x77\x39\x7c\x7d\x31\xf4\x9e\x3c\xde\x60\x51\xfd\x11\xac\xb1\xaf\x45\x1c\x33\x73\x12\x0a\xc1\x34\xcd\x3c\x64\x81\xd2\xf5\x2e\x35\x96\x1a\x61\xc6\x50\x26\x45\x04\x4a\x81\x42\x82\xdb\x01\x83\x40\xe5\x10\x1d\xa1\x0e\xad\x04\x1b\x63\x34\xc6\xbe\x95\xb5\xd7\x09\xa9\x43\xcf\xb0\xd0\xe7\x2b\x2c\xa0\xb4\xc8\x76\xb4\x40\x7f\x70\x07\x03\x88\xec\xae\xfa\x5b\x49\xf7\xd3\xdf\x81\x2c\x27\x76\x9e\xbb\xb0\x33\x16\x32\xad\x25\x10\x32\xf5\x29\x67\x94\x43\x11\x22\xe5\x09\xe1\x12\xbb\x41\xde\x6e\x8b\xa5\xc8\x33\xe7\x52\x73\x47\xe3\x11\x30\x34\x16\xb2\xeb\x0d\x5f\x5f\xbc\x7b\x3b\xfc\x34\xb8\xf4\x7a\x9f\x06\x97\xa1\x31\x4c\x27\xb4\xf3\x2d\x7b\x29\xcf\x72\xbd\xc2\xca\x1c\xdb\x52\xb0\xe6\x85\xec\xa1\x09\x66\x39\x74\xbd\xcf\xfd\xcf\x28\xf8\x34\xb8\x1c\x62\x19\x83\x46\xfd\xcf\xfd\x02\x07\x0d\xb6\x6e\x36\x4e\x04\x2d\x01\x83\x46\x9a\xfb\x04\xc6\x38\x67\xba\x01\x1e\x05\x9c\x9c\x61\x8d\x0f\x1a\x0c\xb6\xa0\x07\xdd\x8a\x20\xd0\x88\xc3\x0d\xfa\x34\xb8\x44\xba\x50\x61\x15\x41\xfd\x0f\xed\x51\xd0\x82\x04\x33\x6c\xec\xf6\x57\x9e\x51\xff\x57\x90\x1c\x5c\xf4\x4f\x2f\xbd\xde\x40\x8a\x48\x3d\x0c\x20\x2d\xe9\x25\x18\x4b\x86\x0f\x03\xc4\x82\xf8\x7d\x80\xb0\x3c\xc5\x90\x4d\x1d\xee\x8e\x43\x17\x0a\xef\x07\x70\xee\x4c\x0b\x18\x44\x46\xec\x28\xc1\x3c\x06\x9b\x5c\xf0\x18\xc8\xe8\x40\x27\x54\x3d\x71\x43\x48\xd8\x5a\x1f\x19\xa7\xd1\x31\x82\x2f\x28\xf8\x35\xa1\x51\x72\xf6\x06\x79\x1c\x6e\xc8\xc8\x33\x4e\x44\x05\x69\x20\x76\x1d\x70\x62\x07\x4b\x7f\x17\xcb\x7a\x43\x50\x1a\x1d\x9c\xbd\x41\x1a\x70\xfa\xc4\xda\x7b\x84\x15\x74\xc2\x82\xc3\xbe\xcc\x05\x23\xb7\xb3\x36\x8b\x0c\x8a\x49\x1e\x59\x42\x07\x82\x91\xbb\xb3\x66\x22\xc2\x2c\x11\x4a\xdf\x2e\xc0\x72\x69\xef\xbc\x7a\x44\x67\x6f\xda\x39\x77\xc2\x82\xdc\xd7\xde\x68\x27\x65\xda\x28\x18\x2b\xc0\xa8\x76\x3f\x7a\x9c\xa0\x29\x83\xaf\x11\x6e\x84\x42\x39\x98\x62\x93\xb4\x13\x0a\xa8\x91\x3d\x2f\x23\x90\x50\x38\xd8\x37\x71\x68\x5d\x5d\x46\xe2\x07\xca\x18\x4a\xa8\xd6\x20\x5b\x72\x0a\x74\xa0\x13\x98\x3d\x66\x0c\x49\x50\x45\xfe\x95\x6b\x91\x62\x4d\x23\xcc\xd8\xec\x89\x87\x7a\x7b\xa4\x0d\x1b\x43\x6b\x03\x2b\xaf\x8d\x97\xe2\xb1\xa8\xee\x42\x53\x9a\xd5\x55\x5d\x55\x5a\x56\x6e\x1a\x42\x9a\x31\xac\x61\xa5\x9a\x9e\xfa\x55\x85\xea\xb9\x6a\x41\x29\x6e\x5a\x32\x76\x77\x29\xd1\x54\x60\x3e\xcf\xa4\xc8\xd4\x62\xf1\xcd\xa6\x97\x2b\x99\xe6\xf3\x57\xd7\x30\x5b\x2c\xbc\xed\x87\xae\xca\x23\x7b\x1c\x9a\xe7\xa9\x72\xf9\x6e\x87\xec\xb8\x70\x5a\x9e\x29\x90\xa6\x7c\x43\x5a\x20\x9d\x00\x9a\xcf\x5f\x19\x39\x17\x0b\x64\x04\x02\x1b\xbf\x2e\x0e\x35\xda\xcc\xb2\x4b\x8d\x35\x1c\x78\xb5\xf8\xdf\xa2\x3a\x81\xef\x55\x83\x2e\xe7\xcf\xe7\xe1\xba\x51\xda\x1c\xfb\x3f\xe9\x88\xaf\x32\xbe\xc8\x36\x6c\x4f\xf9\xbd\x5b\xdf\x16\x0f\xf7\x65\x7c\x77\x0b\xa8\x2a\xe8\x77\x8e\x24\x13\x94\x1f\xfb\x67\x6f\x77\xec\xb7\xd4\x0c\xfc\xf9\xbc\x47\xc9\x62\xf1\x97\x74\x60\x1e\xae\xe5\x32\x9f\xf7\x38\x4e\x61\x05\x78\x68\x9f\x06\x87\xab\xc5\x91\xb3\x8a\x35\xc7\x13\xc4\xf1\xc4\xcf\x28\x63\xca\x43\x52\x58\xf8\xe1\x11\x6b\xb6\xbd\xea\x7d\x8c\x36\xf6\x59\xeb\x21\x1c\x69\x3a\x01\xd7\x65\x84\x9b\x6b\x6d\x9b\xb3\x5c\xbb\x86\x75\x3c\xaa\x5a\xf7\x7f\x8b\x25\xce\x92\xda\x71\x4b\x69\xbc\xde\x3b\x33\xd3\x09\xf1\xe6\x2d\xc0\x36\x4a\x4d\x87\x98\x77\x95\xaf\x0a\x1d\x2f\x62\x80\xe5\xa9\xc9\x28\x41\x1e\x94\x82\x3e\xa9\xe5\x67\x22\x56\x4e\xf1\x1d\xd1\x78\x2e\x62\x55\x36\xb9\x6a\x18\x58\xb2\xa8\x6c\x94\x59\x24\x33\x11\x97\xc3\x4b\x3c\x1f\xb6\xb6\xcc\x76\xb1\x4f\x27\xcc\x59\x3b\x5a\x35\x1e\x55\x01\xb1\x35\x75\x35\xeb\x32\xcc\xa1\xb6\x99\x11\xb6\xcd\x7d\x66\x21\x73\xb9\xe0\x96\x30\x59\x5f\xe3\x6c\xb7\xd5\x66\x69\xad\x1e\x9d\xc9\xfc\x2d\x59\x56\xa5\x5f\xed\x05\x97\x5f\xef\x5d\x2f\x9b\xfd\x58\xc0\x55\x5c\x23\x29\x18\x73\x1c\x65\x2b\xa4\x4c\xbc\x42\x43\x72\xe6\x12\xdb\xbd\xd7\xec\xb6\x7d\xf0\x4e\xa8\x97\xfd\xf0\x4d\x1b\x5a\xaa\x7b\xd5\xec\x5f\x63\x75\x73\x43\x97\xd5\xe8\x3e\x76\x6b\xd1\x6d\x3e\xa7\x63\xa4\xcc\x3d\x87\xba\x5d\xe4\xd9\xd6\xdc\xc6\x39\x5a\x52\xdd\xa5\x07\xb9\x44\x80\x3b\xb5\x6a\x64\xd3\xc5\xae\x01\xc3\xb3\x81\x04\xa5\xea\x93\xe2\x71\xab\x17\x76\xef\xa3\xa1\xad\xbd\xc4\x42\x71\x60\x6a\xf3\xca\xb8\xa3\xaa\xbb\xa8\xb7\x7f\xd5\xbe\x75\x47\x33\xf7\x0c\x8d\x49\x90\x29\x7e\x6d\x26\xd4\x8e\xe7\xdd\x3b\xb2\xbb\x58\x32\xa4\x63\xa7\x21\x5b\x70\xef\x04\xe8\x11\x2a\x4e\x46\xca\xc7\xc2\x57\x29\x66\xae\x43\xa3\xde\x6b\x5c\x51\x34\xcf\xbe\x64\x8d\xf0\x2d\xfb\x69\xf3\x79\xaf\x9e\x34\x29\x5a\x8b\x14\x0d\x5a\xa6\xf2\x6a\xd0\xb1\xaf\x86\x8c\x7d\xd8\x42\x62\x1f\x0d\x8f\xdb\x14\x6a\xde\x6f\xb6\xee\x64\x30\xd6\xe5\x4f\x12\xd5\xcf\x14\x2b\x37\x5b\xeb\x8f\x14\xee\x2b\xcf\xcd\xc4\x16\xb7\xa8\xac\xab\xdd\x8d\x6b\x64\xbd\x3b\x16\xd2\x2e\x53\x2d\xc1\xe2\x4c\xf2\x35\x8e\x17\x0b\x23\xf4\x7f\xa4\x10\x3a\x28\xe2\xa4\x35\xe5\x9f\xcf\x5f\xd9\xb7\xc5\x62\x4b\xf6\xdf\xfc\x5b\xb6\xf6\x6d\xa8\x9c\x0a\xc6\x6c\xcf\xe7\x5b\xe4\xd5\x3c\x8b\x94\xd0\xb3\x43\x85\x3c\xb7\xb5\xcc\xbe\x26\xfc\xea\x00\x1c\xda\x5d\x46\x95\x82\x71\xa3\xd0\x40\xc6\x84\x8d\xa8\xac\xe4\xd9\x1e\x57\x36\xb2\xc6\x42\xb6\x9d\x51\xad\xde\xde\x07\x94\x6d\xf7\xc2\xb6\x36\xc9\x5a\x47\xe4\x81\x0f\xb6\x07\x6d\x90\xb4\x9a\xeb\x5e\x3b\x27\x5b\x7e\xe6\xe7\xa0\x09\xc7\xdb\x3f\x5a\x39\x0c\x0e\x9d\x1f\xad\x6c\xfb\x80\x60\xf9\x13\x3f\xa3\x1c\x30\x27\x23\x2c\xd5\x0e\x5f\x1d\x08\x02\x41\x42\xe3\xc4\xe6\x8d\xca\x8a\xd2\x78\xdd\xfc\x50\xa0\xc8\x87\x3a\x61\xf1\xc1\xd7\x7f\x03\x00\x00\xff\xff\x94\x49\xd0\xcc\x08\x26\x00\x00")
+var _assetsIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe5\x1c\x6b\x73\xdb\xb8\xf1\xfb\xfd\x0a\x1c\xaf\xd3\xd8\x53\x4b\x94\xed\xbc\xec\x93\x34\xf5\x23\x97\xcb\xc5\x89\x5d\xdb\xb9\xbb\xb6\xd3\xe9\x40\x24\x24\xc1\x22\x09\x86\x00\x2d\xfb\x3c\xee\x6f\xef\x2e\x00\x52\x94\x44\xca\x7a\xd9\x75\xa6\x99\x89\xcc\x17\x16\xbb\x8b\x7d\xe3\xd1\xfc\xde\x17\x9e\xba\x8d\x19\xe9\xab\x30\x68\x7f\xd7\x34\x7f\x08\x69\xf6\x19\xf5\xf1\x02\x2e\x43\xa6\x28\xf1\xfa\x34\x91\x4c\xb5\x9c\x54\x75\x6b\x6f\x1d\xfb\x4a\x71\x15\xb0\xf6\x27\x11\xf5\x04\x39\x11\xd4\x27\x97\x4c\x2a\x96\x34\x5d\xf3\xa2\xd0\x3e\xa2\x21\x6b\x39\xd7\x9c\x0d\x63\x91\x28\x87\x78\x22\x52\x2c\x02\x78\x43\xee\xab\x7e\xcb\x67\xd7\xdc\x63\x35\x7d\xb3\x45\x78\xc4\x15\xa7\x41\x4d\x7a\x34\x60\xad\xed\x7a\xc3\x99\x06\xe5\x33\xe9\x25\x3c\x56\x5c\x44\x05\x68\x25\x1f\xd2\x54\xf5\x45\x52\xf8\xe6\x20\x08\x58\x44\x4e\x52\x8f\x65\x5f\x07\x3c\x1a\x90\x84\x05\x2d\x47\xc2\xa7\xca\x4b\x15\xe1\x1e\xc2\xed\x27\xac\x0b\x10\x24\x50\x2e\x5d\x78\xe4\x76\xe9\x35\xbe\xa9\xc3\x8f\x43\x24\xff\x83\xc9\x96\xb3\xf3\xea\xf5\x0d\xfc\x07\x60\x06\x9a\xc1\x8b\xc8\xc4\x6b\x39\xae\xeb\x09\x9f\xd5\xaf\xbe\xa6\x2c\xb9\xad\x7b\x22\x74\xcd\x65\x2d\xa0\x0a\x58\x55\xbf\x92\x4e\xbb\xe9\x9a\x16\xd3\xc8\xa8\xdb\x80\xc9\x3e\x63\x2a\xc3\xc4\x75\x43\x7a\xe3\xf9\x51\xbd\x23\x84\x92\x2a\xa1\x31\xde\x20\xd8\xfc\x81\xbb\x5b\xdf\xad\xbf\x71\x3d\x29\x47\xcf\xea\x21\x87\xaf\xa4\x74\x74\x0f\xe6\x1f\x07\x6e\xf4\x12\xae\x6e\x91\x68\xba\xfb\xf6\x65\xed\xf0\xd7\xbf\x73\x7e\xf1\xe1\x27\xf6\x71\xdb\x7f\x1f\xfe\x72\x7e\x30\xb8\xf5\xd2\x9f\x0f\x7e\x3e\xef\xed\xee\x9c\x86\x5f\xbc\xe1\xf0\x8d\x88\x76\xcf\xff\xee\xf7\x5e\xfe\x4a\xff\x72\x16\x5e\x5c\xca\x3f\xdc\x8f\xaf\xdf\x5e\x77\xfc\x77\x57\xfd\x97\x69\x11\xba\x97\x08\x29\x45\xc2\x7b\x3c\x02\xfe\x45\x22\xba\x0d\x45\x2a\x33\x7e\x8f\x73\x68\x5e\x92\xae\x26\x29\xba\x1a\x23\xa8\x8c\xa4\x4b\xef\xd5\x87\xbf\xf1\x4e\x63\xe7\xcd\xd7\xeb\xdb\xab\x8b\x4f\xdd\x9f\xaf\x4e\x3f\xd1\x93\x41\x37\xfd\xed\xd7\x9b\x7f\xdc\x7c\x39\x8b\x8e\x7e\x39\x78\x13\xec\x84\x47\xbf\x7d\xfe\x10\xbf\xdf\x0b\xdf\x1f\x1d\xbf\x1d\xbe\xff\xfc\xc1\x3b\x3b\x7e\x73\x79\x43\xc7\xe1\x57\x11\x35\x1a\xc0\xc2\x08\x8e\x89\x0e\x8e\x06\x8f\x7c\x76\xa3\x47\x61\x6a\x74\x73\xc9\xc1\x47\x04\xf5\xb1\xe5\x28\x76\xa3\xb0\x9d\xe5\x19\xe9\x08\xff\x96\xdc\x65\xf8\xc4\xd4\xf7\x79\xd4\xab\x29\x11\xef\x93\xd7\x8d\xf8\xe6\x47\xf3\xe6\xde\x00\x72\x35\xa4\x0c\xec\xf7\xb5\xda\x3f\x79\x97\x04\x8a\x7c\x78\x47\xf6\xfe\x65\x01\x8e\x0f\x43\x5f\xa9\x78\xdf\x75\x51\xff\x5f\xc9\x3e\x0f\xeb\x3d\x21\x7a\x01\xd3\xd2\x8b\x83\x21\xaf\x23\x57\x25\x69\x34\x30\x9f\x94\x09\xee\xf7\xff\x64\x91\xcf\xbb\xff\xaa\xd5\x4a\x18\x01\x8a\xe0\x47\x57\xb2\xee\x05\x22\xf5\xbb\x01\x4d\x0c\x58\x7a\x45\x6f\xdc\x80\x77\xa4\xdb\x05\xf5\xac\xd1\x21\x93\x22\x64\xee\xcb\xfa\x9b\x7a\x43\x73\xad\xf8\x38\x17\xe3\x69\xf5\x28\xe5\xd9\xa4\x26\xce\x46\xc0\xea\x28\x97\x02\x98\xca\x40\xe6\x1a\xf5\x6d\xd7\xde\xd5\xe3\x41\xcf\xcf\x64\x6e\x92\xee\xc5\x7a\x91\x09\x70\x89\x25\x6e\xa3\xbe\x57\x7f\xbb\x9b\xdf\x97\x00\x9f\x86\x6e\xa5\xe9\x2a\x13\xa6\x49\x64\x9a\x6e\x66\xb6\x9b\x28\x2e\x16\x3f\x9f\x5f\x13\x2f\x80\xb6\x2d\x07\xb4\xc3\x77\x08\xf7\x5b\x4e\x27\x10\xde\xe0\x84\x4b\xe5\xe4\xe2\x00\x62\x42\x8e\x4e\x3f\x5f\x9e\x9f\x9e\x90\xc3\x93\xd3\xa3\x8f\x04\x47\xd2\xbe\x9c\x00\x52\xe3\x8a\x85\x79\xd3\x8a\xf7\x35\x6b\x72\x89\x4f\x65\xbf\x96\x82\x51\xaf\x75\x44\x02\xc4\x16\x1a\x8e\x37\xcd\x3f\xd4\xd6\x9a\xf2\x88\x25\xa6\xb1\x2f\xd2\x4e\xc0\x3a\xbc\x37\xd6\x74\xbc\x71\x22\x86\x13\x6f\xf1\xbd\xf6\x44\xd9\x27\x9e\x08\x6a\x37\xb2\xb6\xbd\x33\xf5\x21\x72\x3a\xa6\x51\xfb\x28\x48\x8d\x07\xd3\x77\xe5\x1f\x69\x06\x26\x4c\x7b\x08\x0b\xb8\xcf\x7d\x9f\x45\x4e\xfb\x1c\x9f\x46\xcc\x53\xa0\x9c\xf5\x7a\xfd\x61\x30\x11\x28\x98\x27\xd2\x48\xe5\xa0\xe2\x34\x08\x6a\x60\x64\xfa\x4a\x0f\x6e\x79\x7b\x6a\xd5\x0a\x34\x52\xba\x65\x4d\x89\xa2\x49\x0f\xfd\xf5\xbf\x3b\x01\x8d\x06\xce\x14\x08\xf8\xe7\x53\x45\xc1\x82\xf4\x40\xcf\x41\x77\x84\x08\x14\x8f\xa1\x21\x32\xac\xe5\x20\x25\x89\x2f\x89\xe8\x82\xb1\x91\x8a\x60\x4f\x44\x44\x44\xf5\xb9\x24\x88\x35\x10\x0b\x8f\xfe\x1c\x75\x64\xfc\x63\xd3\xa5\x53\x9c\x77\xfd\x42\x10\x50\x78\xca\xaf\x27\x1e\xf5\x93\x45\xc7\x74\xf4\x7e\x34\xa0\x9a\x9b\x18\xa5\xa8\x5a\x08\x82\x83\xbc\x9b\xee\xaa\xe4\xd1\x32\x9d\x11\x34\x34\x35\x0f\x44\x7b\x42\x96\x6d\x9b\x58\x23\xf3\x35\x96\x4a\x28\x1a\x00\x2a\x1d\x11\xf8\x80\x90\xfe\x63\x39\x26\x06\x6d\xf8\xa0\xe9\xc2\xdf\xa6\x1b\x97\x70\xef\x29\x90\x27\x3d\xf0\xa5\xfd\x1a\x8f\xba\xa2\x26\x43\x1a\x04\x86\x8d\x2c\x49\x20\x0c\x42\xdc\xe5\xa3\xf2\xd1\xf4\x06\xa1\xd0\xb3\x1a\x37\x0c\xcd\x22\xef\x76\xf6\xd8\x85\x92\xc4\x7b\x7b\x4f\x3e\x7c\x6f\xcb\xd0\xee\xa4\x4a\xa1\x66\x6a\x1f\x68\x6e\xac\x91\xd7\xd7\xff\x8e\x03\x7a\x9b\xdb\x88\x8e\x82\x97\x22\xf2\x02\xee\x0d\x5a\x2f\x14\x0b\x82\x77\xd7\xe0\xf7\x44\xc4\x36\x9c\x8b\xcb\x83\xf3\x4b\x67\xf3\x45\x99\xb1\x78\xd8\x62\x5c\x80\xc9\x51\x04\x84\x88\xc4\x89\xf0\x18\xf8\x2a\x6d\x30\xf0\x01\xda\x0b\x59\x82\x3a\x20\xcf\x33\xc4\xba\x94\x74\x69\x4d\xe3\x0a\x2c\xe5\x25\x74\xba\x86\x9e\x25\x38\x00\x8a\x18\xcf\xc9\x81\xd3\xb3\x15\x18\x20\xe2\x55\xe9\xd7\x98\x2e\x4c\x3f\x8f\x62\xc8\x59\x32\x40\x22\x31\xae\x37\x11\x41\x31\x32\x72\xc8\x35\x0d\x52\xb8\x31\xac\x81\x08\x32\x61\x18\x4c\x61\x1a\x03\x59\xcc\x4b\xb8\xc2\x88\x0a\xbc\x30\x97\x38\x0c\xfb\x10\x50\x43\x04\xc7\x6a\x3a\x56\xf8\x91\xe8\xe4\x6c\x9f\x40\x3e\x25\x9c\x2a\x06\x41\x3b\x8f\xf5\x41\x55\x58\xa2\x13\x2d\x4b\x79\x39\xdf\xaa\x80\x58\x76\x7e\xc6\xa6\x44\x09\x40\x0b\x04\x6b\x8b\x20\x6b\xe0\x97\x29\xf2\xb7\xb3\x0b\x42\x23\x5f\xf3\x19\x79\xbc\x4f\xb4\x93\x23\x40\x39\xb2\x7b\x4b\x67\x7e\x92\x6c\xf4\xb9\x02\x4d\xaf\x6d\x6f\xd9\x8b\x9d\x4d\x02\x5f\x04\xb4\xc3\x02\x78\x0b\xec\x61\xad\x04\x02\x26\x96\x6c\x3a\x73\x68\x70\xa9\x42\xbe\x2c\x53\x48\x1c\x81\xb1\xd1\x30\x8c\x34\xe6\xc7\xba\xf6\x32\x59\x28\x80\xd7\xcd\x7a\x89\x48\xe3\xd2\x4f\x31\xb8\x46\x32\x90\xe4\x96\x03\x6a\xfb\xfe\xdd\x25\x70\xc5\x69\xc3\x8f\x8b\x3c\x6f\xba\xfa\x7d\x45\xdb\xc5\xe4\xe5\xf7\xd3\xdf\x49\x1d\x00\x5f\xea\x90\x82\x9c\xfe\x7e\x6a\x24\xa8\xd0\x6d\x79\x37\xa5\x6a\x39\xd2\x42\x02\xff\x6b\x3e\xeb\xd2\x34\x50\x05\x8d\x94\x10\x10\x1f\x83\xbc\x6c\x14\x3a\x98\xa1\x92\x0f\xab\x25\xe0\x1c\xb1\xa1\x96\x1a\x13\x15\x4d\xa8\xe5\xe9\xc7\x6a\xd5\xaa\x90\x04\x7c\x8c\x7c\x7b\x3a\xc3\x5f\xe1\xaa\x3d\x13\xaf\x1a\xba\x4a\x9d\xe7\xf3\x15\xdb\xa3\x93\x2f\x17\x97\xef\xce\x73\xb9\xf5\xb2\xd8\x7b\xcd\xa2\x6b\x63\x7a\x1c\xff\x5c\x76\x8b\x7d\x3f\x8e\xf0\x16\x7a\x58\x55\x7a\x69\x51\x76\xd1\xc8\xa9\x3e\x23\x43\x30\xb3\x98\xd8\x68\xda\xc0\x2e\xc6\x01\xa4\x4d\x54\xd7\x28\xf4\xfb\x6f\x49\xb8\xbf\x31\xc1\x3d\x3b\x3f\x3d\x02\xb9\x39\x43\xf7\xf3\x38\xd6\x56\x83\x1e\x49\xab\xed\xf0\x71\x04\xd5\x00\x5f\x87\x85\xb5\x71\x0f\xd1\x09\xed\xea\x46\xb6\x4c\x0a\xd7\x23\x70\xaf\x2b\xac\x29\x46\x03\x59\xdc\x3f\xa7\x40\xbe\x7e\x0e\x02\x79\xfe\xee\xe0\xf8\x02\xab\x0f\xd4\x7f\x24\x81\x44\xd0\x63\x06\xd4\x74\x69\x83\xc7\x97\xce\x77\xcb\xca\xce\x31\x95\xfd\x8e\xa0\x89\x4f\xb0\xfe\xc6\x21\x6c\x8b\x21\x2f\x95\x58\x47\xf1\x51\x84\x18\xf5\xfa\xb6\xde\xf0\x20\x1b\x70\xf8\x12\xaa\xb8\x70\xda\x60\x24\x11\xce\x30\xe1\xea\x71\x98\x71\x8e\xfd\x8c\xd8\x31\xea\xfa\xb1\x58\xa2\x49\xd9\x22\x02\x72\x95\x84\x63\xf1\x97\x24\xf9\x70\x83\x33\x60\xa0\x6d\x1d\x78\x49\x1a\xeb\xb7\x13\x5a\xae\x36\x56\xb5\x10\xe8\x94\x10\x65\x32\x14\xc9\x20\xc0\xd9\xa2\x6f\x2f\x0c\x7b\x35\x65\x38\x88\x2e\x96\xd8\x12\x9e\xce\xab\x3a\x22\x51\xcc\x9f\xdf\x82\xbc\x79\x0e\x16\xe4\xfd\x97\x83\xf3\xe3\xf3\x83\x0f\x27\x60\x46\xde\xa7\x20\x7b\x09\xe5\x81\x5c\xbb\xe6\x8c\x40\x8f\x54\xa7\xd0\xf5\x28\x17\x9d\x25\x6a\x63\x49\x66\xbc\xb7\xd7\xda\x69\x34\xcc\x30\x80\x06\xb2\x56\xa3\xde\xd8\x86\x24\xaf\xd7\xda\x6e\x90\x6e\x1a\xf9\x12\x2e\x1a\x9a\xca\x57\xcb\xeb\xa4\xce\xed\x19\xd6\x09\xc8\x48\xe1\x04\x0c\x88\xe8\xa2\x60\x4b\x46\xb8\x24\x9d\x04\xad\x15\xf3\x75\x8c\xf6\x02\x7e\x5e\x58\x43\x26\xf7\xb1\x5a\x44\x42\xb9\x65\xf0\x34\x1a\x2d\xe0\x07\x4d\x46\xb4\x05\x7a\x01\xe1\x9b\xa7\x6f\x10\xf5\xac\xd9\x16\x09\x18\x16\x5f\xa9\x0f\x3d\x2b\x2e\xa1\x91\xa6\x68\xfd\x2a\x3e\x1a\x98\xb5\xe8\x79\x6f\x34\xce\xdf\x9e\x92\xbf\xfe\xc6\xbc\xff\xe1\x97\x93\x8f\x4e\xfb\x30\x0d\x06\xa4\x83\xb5\xd4\xb5\x6b\x2d\x82\xbe\x00\xcd\x1c\xe9\xac\xee\x72\x79\x07\x27\xbc\x34\x64\x91\x32\x6a\xa0\x11\x4f\x63\x10\x6e\xb5\x45\xb4\xa2\x6a\xc5\xa2\x98\xf7\x28\x1e\x56\x3a\x7e\xc9\x02\xe6\x29\x5b\xe6\x0b\x06\x7a\x66\x09\xed\x6e\x05\x5a\x4d\xa1\x17\x0b\x10\xa4\x88\x77\x0d\x51\xa7\xa6\x0d\xd2\x45\x0c\x38\xb8\xc1\x0f\x40\x23\xf4\x43\xcb\x85\x1c\xb6\xbd\x68\xba\x06\xd8\x9c\x7d\x45\x42\x2d\xd6\x5f\x1a\xe5\x3d\xe6\x97\xb3\xfb\x6c\xba\x06\xde\x7c\xbc\x42\x3b\xe9\x2c\x8a\xfc\xe1\xed\x21\x4a\xd7\x83\xc8\xfb\xc2\x73\xda\x01\x0f\x21\x1d\xc5\xe1\x85\xdb\x05\xd9\xb5\x50\x6f\x5a\xe2\x8b\xfd\x59\x15\x58\x81\x59\xcb\x5b\x51\x44\x7c\x65\xfb\x89\x03\x44\x42\x74\x32\xdf\x9e\xe9\xdc\xad\x48\xac\x4c\xed\x42\x2a\xaa\x52\x39\xbf\x75\xdd\x7b\x0e\xd6\xd5\xa0\x8e\x15\x65\xa7\x7d\x7c\x68\xab\x30\x6b\x31\xb1\x23\xc6\x68\xe8\x36\xf8\x79\xbd\xbc\x5d\xfd\x0c\x60\x6c\x40\x92\x55\x8b\xb0\x7c\x4e\xaf\x21\x6f\xc0\xe5\x46\x58\xfd\x4e\x58\x08\x59\x82\xb3\x2e\xcc\xd3\x84\x17\x67\x10\xc6\x42\xb3\x10\xd7\x85\xf9\x9d\x7d\xd7\x4d\xc1\xb8\xef\xc7\x00\xf4\xaf\x7d\x21\xd5\xf6\x16\xfe\xee\xb8\xb8\x32\xcb\xef\x2c\x4f\xee\x91\x9d\x6a\x07\x35\xf9\x72\xfe\x81\x6c\x50\x62\xa6\xe1\x71\xc2\x5a\x42\x92\xe1\x63\x4c\x36\x60\x31\x38\x12\x49\xc0\x3a\x0c\xa9\xdc\x5c\x1b\xe1\x80\x78\x3e\x60\xe3\x64\x23\xce\x1d\x2a\xd9\x2a\x09\xa0\x81\xa0\x07\x12\x74\x21\x30\x54\x4a\x42\x13\x88\x33\xa3\x39\x1c\xa2\xc1\x11\x19\xec\xcc\xee\xea\x00\x3e\x21\x21\xf3\xfa\x34\xe2\x32\x7c\xd0\x27\x64\x33\x48\x6d\x6b\xff\x70\x36\xa8\xff\x90\x79\x47\xab\x9d\xd0\xa8\xc7\x48\x1d\xbb\xfb\x94\xf5\xa6\x73\x80\xf9\x3a\xd4\x61\x88\x0e\x3f\xda\xf9\xe5\x3c\xdd\x5a\x67\xb1\x8c\x17\x58\x58\x22\x62\x81\xef\x8c\x4c\xec\x4e\xc8\x84\x7e\xb7\x7c\xf2\x21\xbc\x01\x53\x59\x65\x24\xb9\xc6\x7a\xaf\x09\x94\x22\x41\xb4\xe7\x9b\x69\xc7\xda\x96\x16\x83\x36\x24\x28\xde\xa0\x23\x6e\x8a\xa8\x2b\x9c\xe8\x27\x97\x27\x17\xb3\xed\xda\x9c\xd0\x78\x04\xe9\x4b\x9a\x80\x91\x21\x34\xba\x25\x1e\x04\x76\x6b\xb6\x97\x1e\xcd\x18\xfd\x76\x82\xd1\x47\x07\xa4\xcb\x83\x15\x74\xef\xec\xdd\x27\x0d\x41\xd7\x9e\x46\xf9\x1e\xd8\x51\x4d\xaa\x56\x49\x33\x06\x2f\xa4\x26\x8d\x77\x31\x71\x83\x78\xb5\x47\x81\x70\xb5\xe6\xd4\x0c\x6c\xb7\x99\x76\x5b\x2d\xac\x00\x30\x45\xaf\x30\x1e\x58\x4c\xcd\x41\x53\x74\x10\x38\x07\x3d\x2b\xe0\x58\x9a\x26\xf4\x44\x6b\xa0\xc9\x5a\x7f\x1c\x99\x02\x65\xdd\x44\x84\xc5\x35\x4a\xd3\xeb\x0b\xd2\xde\x23\xd2\x66\xbc\xeb\x1a\xa8\x3b\xd7\x80\xa6\x28\x9b\x31\x6a\x2a\xa1\xb2\xff\x30\x69\xcf\x7b\x3a\x13\xd4\x0a\xf4\x69\x91\xea\xd9\xcb\xd9\x81\xdf\x92\xe3\x88\xde\x17\xa3\xca\x8d\x65\xd7\x82\xfc\xc6\xc1\xaf\xe6\xa3\xa5\xd7\x2e\xc4\xcc\xdf\xc2\x6c\x0a\x8b\x47\xd4\x94\x49\xb2\x50\x81\xa0\xf9\x42\x91\x1d\xea\x75\x0d\x7a\xc1\x83\x99\x45\x31\x75\x5e\x5c\x8e\x01\x43\x7e\x68\xb1\x5a\x74\x25\xcc\x83\xe4\x6a\xb6\xdf\xae\x9d\xd8\x91\xc5\xcc\x09\xb5\x56\x52\x3f\x0c\x44\x4f\x5a\xe2\x20\xb7\x93\x3c\xf2\x8c\xc0\x67\xcc\x77\xda\xbf\x6a\xbc\xaa\xc8\x7d\xbc\x02\x50\x85\x74\xd2\x24\x5c\x44\x34\x9f\x45\x71\x48\xcb\x12\xc6\x8c\x66\x79\x16\x8f\xd6\x59\x1d\xda\x6e\x48\xc3\x99\xac\x97\xd5\x27\x3f\x0c\x9a\x63\x9e\x17\xac\x5f\x9f\x25\xc6\xaa\x07\x90\x53\x18\x53\x18\x95\x8c\x65\xc9\x94\x50\x0a\x63\x98\x38\x6d\xf8\x59\x27\xe1\x76\xd2\xc7\x00\x5f\x9d\xe8\x4b\xac\x26\xcb\x89\x12\x73\x4e\x38\xed\xaa\x31\xf2\x37\xcc\x6a\x27\x70\x7c\x03\xc6\x62\xd2\x13\x60\x24\x36\xd7\x1c\x77\x60\x19\x3b\x0d\xd8\x4a\x3e\xec\x20\x99\xe9\xb0\x3c\x5c\x56\x56\x13\x8f\xe8\x8d\x7d\x2e\x41\x65\x57\xa2\xe1\x58\x83\x98\xe9\x77\x79\xa8\x1f\x7f\x13\x7e\x77\x7b\xa7\xc2\xb4\xf9\x60\x71\xaf\xf5\x64\xe3\xe2\x4b\xc5\x7f\xeb\x73\x3b\x47\x8b\x53\xff\x51\x97\xa3\x99\x34\x16\x1e\x27\x2f\xba\x6c\x08\x8f\xc3\x90\xea\x89\x8b\x27\x37\xda\x71\x22\x30\x9e\x87\x3f\x3d\xbd\xde\xf1\xdb\x32\xdf\x16\x7b\x9d\xd3\xe0\x82\x13\xbc\x58\xd1\x90\x69\x58\x84\x7a\x1e\x8b\x55\xcb\xa9\x5f\xc9\x6c\xb1\x6c\xb1\xaf\xa5\x4d\xd9\x2f\x17\xa7\x9f\x89\x9e\xdd\xb5\xe0\xb0\x20\x95\xd0\x10\x97\x6e\x2a\x86\xbf\x31\x1f\xb0\x2d\x74\xf7\xcc\x04\x3b\x82\x0e\x30\xe2\xe9\xb1\x35\x4f\x6b\x81\x75\xb6\x0c\x5b\x2d\x12\x4f\x23\x2d\xcb\x19\x39\x76\x89\xd5\xec\x65\xbd\x0b\x2e\x6d\x9e\xbd\xbc\x77\x15\x3b\x0e\x3e\x65\x1d\x4c\xd0\xd3\x9f\x63\x5c\x80\x91\x5b\x9c\x05\x33\x56\x37\x3f\xc4\x82\xe7\x3a\xff\x6f\xe3\x1f\x46\x13\xaf\xbf\xb8\x8d\x79\x16\x73\xff\x16\xf9\xbd\x3d\x08\x12\xf5\x25\x86\x16\x7a\x77\xc3\x9a\x8a\x36\xa3\x0e\xf2\x50\x69\xa2\x52\x2c\x97\x37\x38\x3f\x03\x43\xb0\xb6\x8d\x13\xec\x76\xeb\x06\x44\xb9\x24\xe4\x41\xc0\xed\x34\x3a\x4e\x24\x9a\x24\x3a\xa4\xb7\xa4\xaf\x8b\x21\xc2\xae\x52\xa3\x90\xaf\xa4\x12\xf7\x9a\xd1\x4e\xc0\xe6\xe6\x96\x99\xc6\x77\xda\xe6\xef\x9a\x39\x65\x81\x57\x30\x0b\xd7\x37\xac\xce\xae\xb2\x75\x08\x8f\xc0\x27\xb4\xf9\x4e\x1b\x7f\x71\x2b\xd9\x3a\x43\xf0\xdd\x3c\xf7\x18\x75\x94\x57\x5f\x97\x67\x0f\xa4\xe0\x3a\xc8\x06\xc2\x91\xe1\x66\xfd\x59\xb6\xbc\x7a\xcd\xee\xc9\x68\xdb\x4a\x86\xf9\x27\x53\xef\x62\x85\x15\xc2\x5b\x26\x2d\xd0\x5b\x26\xb1\x7c\xc0\x15\xd9\xc8\x77\x4b\x6c\x8e\xd5\xfe\x08\x56\x18\x02\x18\x76\xe4\x1e\xa8\x0c\xee\x59\xd9\x42\x70\x11\x89\x28\x08\xc8\x10\xa0\x0c\x23\xb3\xed\x8f\x91\xbe\x15\x1d\x85\xe5\x0b\x5d\xb1\x98\xdf\xf0\x6b\x4a\xff\x17\xde\x6f\x0d\x3c\xce\x9d\x9f\xa1\xe2\xff\xd5\xf7\x55\xe6\x0e\x23\x05\x7c\xcc\xf8\xbe\x7c\xcf\xee\x68\xca\x6b\x88\x59\x08\xce\xca\xc1\xf0\xf7\x71\xb6\x49\x6f\x07\x85\xbf\x7e\x67\x03\x73\xe7\xf2\x0c\xb9\x30\x37\x75\x7c\x68\xea\xb6\x55\xd3\x52\x13\xab\x14\xd8\x57\x52\xd7\x13\xae\x7f\xaa\xeb\x04\xe8\xf8\xf0\xc1\xb5\x0a\x7a\xea\x4a\xb7\xc9\x67\xb2\x44\x42\xea\x27\xda\x68\xe6\x2f\x66\xcd\x6b\xcd\x9a\xd3\xaa\x9e\xcf\x1a\x63\x13\x2e\x23\x05\xbb\xea\xb1\x24\x9a\xe6\xd5\xd0\xb3\xbc\x5a\xb2\x10\x88\xc0\x89\x85\x9e\x6d\xc7\x9a\xa1\x27\x05\xee\xeb\xa6\x47\xa6\xe5\x02\x23\x80\xdc\x2f\xb4\x9c\x6f\x08\x16\x98\x48\x5c\x86\xe1\xf3\x06\x7f\x6f\xed\x16\x1e\x11\x04\x46\xf7\x17\x08\x1c\x77\x66\xd4\xba\x0b\x76\xd1\xe7\xcc\x3e\x0c\x29\xee\x13\x81\x7b\x52\xdc\x25\x5e\x28\x95\x2c\x5e\xeb\xae\xfc\xda\x4a\xc3\x47\x08\xc1\x88\xd9\x7a\x37\xbe\x25\x12\x6d\xa9\xdd\x7e\x08\xa3\x64\x3c\x11\xc8\x1d\xbb\x7d\x01\x0d\xe0\xa9\xd9\x48\x9a\x2a\x11\x42\x58\xe2\x81\x08\xdd\x6e\x3a\xa4\xbd\x4a\x19\x78\xe2\xc1\xd8\x6d\xe1\xc6\x5c\x9a\xa3\x10\x5c\x3c\xc7\x20\x3f\x02\x21\x3b\x87\x21\x1b\xb0\x4b\x16\xc6\x18\x6d\x8e\x1d\x3d\x71\x53\xcb\x8e\x73\x70\xca\x0e\x4e\x40\x7f\x5a\xbe\x03\xb9\x7c\x77\x74\x91\x80\xbb\x3b\xe0\x60\x2c\xef\xef\xbf\x9b\x1e\xef\x0c\xa7\xbb\xbb\xfd\x01\xbb\xbd\xbf\x77\x66\xfb\x49\x99\x7a\x7a\x97\x06\x5e\xdf\x94\x06\xde\x73\x14\x68\x6d\xf8\xa0\x57\xed\x71\x13\x2e\xe1\xa8\x02\x0a\x88\xe7\xfd\x7d\x61\xd9\x42\x59\x0f\xb9\xdc\xe1\x67\x00\x51\xb1\x0d\x27\x47\x7f\x8b\xe4\x3b\x91\xdb\xd9\xc3\xb2\xc1\xbf\xbb\x73\x27\x99\x52\x35\xb0\xcf\x72\x20\x96\x62\x3e\x84\x22\x93\xbc\xe7\xd1\xda\xb9\xaf\x77\x41\xaf\x8b\xf9\xe5\xe7\xa5\x64\xa7\x5f\xcc\xad\x49\xa8\x94\x9f\x4f\x8f\xdf\xcd\x79\x38\x49\xde\x41\xed\xee\xae\xcd\x7d\xa0\xec\x29\x8e\x2b\x79\xbc\xf3\x49\x80\x0a\x5c\x26\x36\x26\x78\x63\x22\x69\x37\x36\x97\x7c\x60\x8e\x1b\xb1\x7d\x98\x14\x4d\xff\x96\x84\xcc\x7f\xda\x70\x7e\xc8\x76\x86\x6f\xd6\xc1\x69\xa2\x64\xb4\xb5\x14\xb4\xe0\xc2\x88\x57\xf5\x66\xb1\xd9\xf3\x01\x06\xee\x68\x77\xa2\xc1\x22\xd0\xc5\x62\x0e\xce\x6f\xba\xa7\xaa\x03\x50\x4a\xa4\x2f\x17\xbb\xf9\x8e\x1d\x29\x3b\x78\x24\x0d\x32\x26\x45\xf4\x1a\x32\xa0\xeb\x5a\x0c\x2e\x0c\xcf\x1b\x12\x9a\x1e\xc8\x7c\x79\xc9\x0a\x8f\x66\xc0\x0b\xed\xb4\x2c\x11\x0a\xca\x57\xba\xcc\xae\x49\x8b\xdf\xea\x13\x92\xec\xb7\x13\xcc\xa3\x9d\xec\xd4\xaf\x1f\x4c\xd8\x9d\x89\xf1\x08\x1b\xa7\xfd\x1e\xdf\x94\x9e\xbe\x12\xf0\x39\xd0\x5c\x15\xbf\x4c\x6c\x1c\xc0\x33\x10\xc3\x13\x53\x7e\xd9\xb0\x98\x6e\xe6\x04\xd8\xba\x4c\x29\x09\xb6\xd1\x33\x20\xc2\x0b\x20\x91\x39\xc2\x6a\x07\x4b\x4a\x68\x10\x3d\x59\x4a\x40\x89\x2a\x9c\xe0\xcc\xf6\x98\xd6\x79\x06\xac\x5d\xa1\x68\x0f\x3e\x11\x3d\xfb\x78\x64\xa2\x1a\x95\x47\xfe\xcc\xc3\x9f\xa6\x9b\x06\xd5\x06\x08\xb0\xcd\x6c\xdc\xcc\x84\x0b\xbf\x03\x1c\x58\xce\x33\x44\xb6\x4a\x06\xf1\xc3\xa0\x6c\x08\x1e\xb0\x7c\xd5\xde\xb6\x70\x92\x4f\xce\x96\xca\x0c\xb8\x74\xdb\xe8\x03\x21\x74\x46\x9f\x53\x3c\xee\xe5\xe9\x48\xcb\xcf\xbb\x79\x12\xea\xca\xa5\x76\xed\xa4\xe9\x70\x5d\xab\x53\xd6\xab\x07\x9d\x05\x25\xbe\x77\x0c\x14\x9a\x54\x56\xc0\x3c\x28\x43\xbb\xb2\xc2\xa2\xf4\x29\x67\x4d\x57\x8d\x4e\x3b\x9b\xe6\xa1\x86\xba\x50\x55\x65\x19\xae\x63\x48\x69\xab\x95\x8b\xf0\xad\x82\xb6\xbb\x3b\x48\x6f\x71\xb5\x3c\x23\xad\x16\x71\xf4\xcc\x51\x89\x5f\xaf\x2e\x8d\x4d\x9c\xfe\x33\x92\x80\xf2\x5c\xa0\xb8\xf2\x49\xb7\x3a\x83\x46\x67\xe8\xac\x73\x3b\xf8\xe2\x09\xea\x5c\x48\x38\x44\x30\x6c\xdd\xa4\xce\x43\xde\xe2\xe5\xc1\x99\x2d\x8a\xc9\x92\x2b\x4d\x01\x31\x5f\x94\xf8\x14\x13\x86\x10\x21\xf1\x6e\x29\x23\x2b\xe4\x7e\xde\xfd\x1c\x15\xc8\x60\xdb\xd1\x02\xdd\xaf\x71\x41\x7d\xed\x9c\x02\xdc\xe7\x2f\x31\xb2\xab\xc0\xa2\x00\x4b\x57\xac\x47\x70\xcc\x71\x3f\x26\x36\xf4\xe6\x04\x61\xa6\x39\x46\x42\x51\x3a\x92\x76\x16\x87\x34\xe6\x81\xa8\x77\x5d\x3f\x04\x50\x7f\x34\x1f\x3c\x39\x60\xc3\x07\xc0\x15\x27\x27\xba\x34\x21\xa2\xdb\x25\x5c\x49\x92\xad\xd2\xc9\x56\x0c\x9a\xb5\x83\x45\x59\xc3\x3d\x0f\x95\xfe\x65\x31\x51\x78\x53\xb9\xed\xa0\x10\xe6\xe8\xda\x52\xc0\xba\xca\x1e\x9c\x96\x1d\x84\x37\x16\xe0\x54\x1c\x83\x57\xa5\x15\x39\x9c\x78\x6f\x6f\x16\x9c\xb1\x23\xd9\xca\x83\xa8\x72\x7c\x75\x2d\x8c\xd8\x32\x5c\x75\x41\xff\xee\x0e\x0b\x9a\xf8\x99\xac\x30\x50\xa5\x95\x00\x45\x7b\xf7\xf7\x88\xf7\x7f\x12\x21\x54\xdd\x0c\x73\x65\x5d\x00\x1a\xe8\x3b\xc8\xe1\xab\x4b\x04\xa5\xb9\xbc\x11\x9a\x23\xe8\x54\x97\x70\x21\x8d\xcf\xfb\x34\x79\xa3\xa3\x1f\x19\x7c\x1e\x3a\xee\x63\x19\x93\x97\x1b\xbd\x4b\xdd\x0a\x49\x31\x1d\x17\xaa\x11\xf6\xf4\x9a\xdc\x12\x66\xf8\xcc\xb6\x65\xda\x9a\x41\xcb\x2a\xbf\x50\x39\xda\x8b\xc8\xf7\xf6\x03\xd3\x7b\x65\x55\xd5\x89\x02\xea\x23\x3b\x93\x27\xaa\xa7\x2e\x12\x04\x2d\x5f\x68\x9d\x71\x84\x6e\xc4\x94\x1f\xd1\xd9\x07\x42\x37\xea\x8d\xd2\x03\xa1\x67\x1d\xce\x3b\x3a\x3e\x17\x97\x53\x80\xb1\xec\xd0\x44\xce\x71\xa2\x2f\x9e\x81\x8c\x73\x9f\x3a\x6a\x97\x1a\x95\xc2\xed\xf4\x21\xbc\x26\x1a\x6d\xba\xe6\x30\xf5\xff\x02\x12\xf7\xe4\x0d\x64\x5d\x00\x00")
 
 func assetsIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,12 +89,12 @@ func assetsIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/index.html", size: 9736, mode: os.FileMode(509), modTime: time.Unix(1482359841, 0)}
+	info := bindataFileInfo{name: "assets/index.html", size: 23908, mode: os.FileMode(509), modTime: time.Unix(1792318862, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsCssIndexCss = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x59\x7b\x6f\x1b\x37\x12\xff\x7b\xf7\x53\xcc\x35\x68\xfd\x80\x57\x5a\x49\x96\xed\xac\x51\xa3\x8e\xe3\xf4\x82\x4b\xe3\x83\x7d\xc1\xfd\x51\xf4\x00\xee\x92\xab\xe5\x99\x4b\x2e\x48\x2a\x96\x22\xf8\xbb\x1f\x48\xee\x8b\x2b\xd9\xe7\x06\x0d\xda\xaa\x11\x22\x3e\xe6\xc5\x99\xf9\xcd\x4c\xc6\x87\x70\x89\x51\xa5\x09\x86\x5c\x8a\x12\xde\x30\x91\xdd\x2b\xd8\x2f\xb4\xae\x92\xf1\xf8\x92\x7d\x46\x92\x7c\x19\x51\x75\x10\xc2\xe1\x38\x0c\xc7\x87\x70\x75\xf3\xe1\xe6\x16\x6e\xaf\xdf\x5d\xdf\x5e\x7f\xbc\xba\x0e\x23\x78\x35\xc9\xcd\x07\xf6\xdf\xa0\xec\x7e\x21\xc5\x92\x63\xb8\x12\x4c\xc8\x03\xb3\x39\xc3\xe6\x03\xfb\x6f\x91\x2a\xa2\x25\xa7\x1a\x10\xc7\x50\x20\x96\xbb\x5f\x77\x24\xd3\x54\x70\x7b\x36\x47\x13\x3c\xc5\xb0\x7f\x4b\x30\x44\xf0\x49\x11\x0c\x94\x83\x22\x8c\x64\x5a\x48\x75\x04\x15\x92\x12\x2d\x24\xaa\x0a\x75\x04\x29\x92\xca\x12\x13\xba\x20\x8e\x59\x3a\xcd\xce\x66\x13\xd8\xff\x59\x12\xc2\x7b\x24\x34\xd5\x8c\xd8\x2b\x78\x41\x9e\xb8\x8a\xcd\x07\x7e\x80\x57\x79\x9e\xc3\xfe\x3b\xc1\x35\x64\x46\x0b\x75\x10\x1a\xd5\xc5\x3d\x6c\x42\x00\x70\x8b\x49\xcd\xea\x3c\x7c\x0c\xc3\x57\x92\x64\x82\xc3\x26\x0c\x00\x72\xc1\x75\xf4\x40\xe8\xa2\xd0\x09\xa4\x82\xe1\x73\xb3\x5a\x21\x8c\x29\x5f\x44\x8c\xe4\x3a\x81\x79\xb5\xb2\xab\x35\x25\xa7\xb5\xa3\xc4\x05\x26\x99\x58\x72\x5d\x33\x6b\x2e\x4a\x47\xf0\xd4\xdc\x7c\x0c\xc3\x11\xa6\x24\x5d\x6a\x6d\xb9\x9a\x73\x69\x6b\xfa\x04\x24\xa9\x08\xd2\xd1\x0a\xb4\xa8\xc0\x70\x3c\x1f\x1c\x89\x68\x89\x16\x24\x81\xa5\x64\xfb\x63\xa4\x14\xd1\x6a\x4c\xcb\xc5\x58\xdd\x2f\x19\x1b\xa9\xcf\x8b\x83\xad\x1b\x8a\x7e\x21\x09\x64\x82\x6b\x44\xb9\xdb\x7d\xa0\x58\x17\x09\xcc\xa6\x46\x26\xb3\x50\xd4\x5a\x77\x2b\x25\x92\x0b\xca\x23\x2d\xaa\x04\x66\x83\x45\x67\x8a\x68\x32\x6d\x54\x2a\x91\xd2\x44\x62\x4a\x6a\x95\x6a\xfa\xaf\x4f\x5e\x46\x7f\xda\xda\x26\x17\xb2\x8c\x8c\xdc\x55\x4d\xa9\x3e\x96\x0a\xad\x45\x99\x40\xec\x8e\xa1\xa5\x16\x1a\xa5\xf5\x19\xf1\x99\xc8\x9c\x89\x87\x68\x9d\x80\xd9\xf1\x59\x4e\x4e\xce\x1a\x9e\xa9\x90\x98\xc8\x28\x13\x8c\xa1\x4a\x59\xa3\xb8\xbf\xed\xd2\xee\xc4\xc9\xd4\xf2\xba\x80\x91\x46\x29\x23\x9e\x60\x49\x7c\xde\x7a\x8e\xb3\xf3\x24\x6e\x74\x69\x2e\xea\x82\x20\x0c\x17\xa0\xa5\xf9\xc2\x47\xbd\x9d\x54\xe0\x75\xb7\xe3\xfb\x8d\x21\xdd\x09\x9d\x70\xc1\x3d\x31\x9b\x6d\xe7\x88\x0f\x05\xd5\xc4\xb1\xd5\x05\xe5\x35\x89\x01\x41\x98\x56\x2b\xf8\x1b\x2d\x2b\x21\x35\xe2\xda\xa3\xb6\xb5\xf7\x18\x86\x3f\xb9\x9f\xb0\x67\x52\x8a\x4a\xc6\xe3\x4c\x60\x32\x2a\xe8\xa2\xc8\x0a\x24\xb5\x1a\x65\xa2\x1c\x67\x4a\x8d\xfb\x4b\x4a\xed\x9d\x87\x61\xef\x54\xa4\x85\x60\x9a\x56\x17\xaa\x42\x3b\x5d\x7e\x91\xa2\xfd\xd3\xd7\x47\xee\xff\x78\x74\x36\x3f\xf0\x14\x87\xb8\x79\xbe\x56\x8f\x78\x97\x13\x45\x93\x76\xd9\xd9\x04\x7a\x46\x31\x0f\x9d\x2e\xb5\x1a\x84\xa6\xbd\x78\x3a\xa0\xdf\x04\x6c\xfb\x92\xe3\xc3\xf6\xbf\x37\x97\x77\xd7\x70\x75\xf3\xf1\xdd\xfb\x9f\x3f\xdd\x5e\xfe\xeb\xfd\xcd\xc7\xbb\x6e\x73\x0c\x00\xa1\x7d\xd2\x4d\x18\xf4\x54\xac\x93\xac\x61\x62\x1d\x25\x47\x25\x65\xeb\x04\xf6\x6e\x2a\xc2\xe1\x0e\x71\xb5\x77\x04\x0a\x71\x15\x29\x22\x69\x6e\x79\x16\x13\xd8\x84\xfe\xe9\x5b\xc4\xc8\x03\x5a\xfb\x67\xcd\xd1\xd9\x51\x71\x7c\x54\xcc\x0d\xd7\x97\xd1\x0f\xfa\x99\x8e\x99\x6f\x22\x1d\xd7\x69\x4b\xc4\x7a\xf3\xb4\x89\xf0\x62\xe6\x6f\x1c\xdb\xe8\x08\xbc\x7c\x6a\xcf\x59\x29\x06\x69\x36\xe8\x07\xd6\xbc\xa6\xc8\x50\x4a\x98\x97\x96\xfb\xef\xc5\xd1\xe7\x0b\x46\x2f\xd0\xce\x03\x43\x9f\x1e\x84\x60\x17\x81\x36\x1b\x13\xb9\x33\xcf\x62\x2f\x25\x48\x84\xe9\x52\x25\x10\x8f\xce\x48\xe9\x76\xa2\x52\x7c\x89\x9e\xd9\x7e\x20\xe9\x3d\xd5\xcf\x9c\xa8\x85\x36\x98\x94\xe7\xb9\x5b\xc3\x54\x55\x0c\xad\x13\xa0\x9c\x51\x4e\xa2\xd4\x80\xb6\xdb\xda\x81\x3e\x3d\x17\xaf\x7d\x72\xde\xf8\xaa\x26\x2b\x1d\x21\x46\x17\x3c\x81\x8c\x70\xfb\x80\x3b\x7c\x78\x3a\xf4\x6d\x97\xdc\xda\xc4\x2d\xc5\x03\x5c\xc0\xe1\xaf\x19\x43\x4a\xfd\xe7\xc7\xef\x32\xc1\xa2\xef\x7e\x4b\x72\x2a\x95\x8e\xb2\x82\xb2\x41\x52\x72\xf7\xe3\x5d\xbc\xe2\x61\xac\x1c\xbe\xf9\x70\x73\xf5\x8f\x3b\xf8\x01\xfe\x7d\x7b\xf9\xcf\x5e\x9c\xb8\x6a\x24\x8a\x22\x30\x85\x05\x7c\x32\xa5\xc4\xb8\xc5\x7b\xb3\x96\x0a\x24\x31\x54\x68\x41\x20\x8a\xa2\xc3\x71\x38\xc2\x4d\x09\x52\x1b\xdc\x21\x76\x0f\xe5\x1a\x6b\xbb\x9a\xc5\xcf\x20\xc6\x23\x40\x09\x46\xb1\xbf\x3f\x00\x97\xce\x71\x5a\x6e\xb5\xf6\x0d\x96\x4c\xe7\x6d\x8e\xa9\x21\xee\xf8\xd8\xbf\x84\xc5\x32\x65\x24\xa5\x8b\xc1\xcd\xf9\x2c\xde\x91\x7c\x1b\x9c\x9c\x0c\x37\x3d\x21\x6a\x95\x93\xc2\x00\xdd\x93\x8a\x1f\xe7\xe6\x63\x63\xa1\xc3\xb9\xe1\x4e\xed\xd4\xab\x48\x15\x08\x8b\x87\xc4\x08\x31\xab\x56\xf6\x8f\x41\x00\x23\xc7\xab\xc9\xdc\x7c\x86\x6e\xde\xdd\x79\xf6\xc2\x80\x38\x3c\xcb\xc1\xb7\x35\xb6\xa5\x9e\x9f\x68\x26\x13\x1b\xdf\xd6\xdd\xb5\x44\x5c\x99\x0a\x21\x59\x56\x15\x91\x19\x32\xc0\xdd\x24\x9b\x26\xca\x7c\x92\x85\x0b\xfe\xa0\xc5\x92\xf3\xee\x97\xcb\xfe\x93\xce\x35\x3a\x35\x7a\x27\x22\xa5\xd7\x8c\x24\x60\x68\x12\x57\x11\xf6\x50\x67\x56\x67\x1f\xdf\x93\x4e\x77\xd7\x4b\xd3\xf9\x60\xbd\x0e\xd2\x7a\x63\x20\xf9\x64\x3b\x9f\xef\x44\x00\x3f\x9b\xcf\xe2\xf8\xbc\x6f\x3f\x98\x5a\xff\x0c\x6c\xb6\x69\xdd\xd8\x2d\x11\xad\x89\x8c\x54\x85\xb2\x0e\x55\x83\x61\xd6\x0a\xfa\x58\xe9\x22\x24\xf0\x92\x81\xab\x88\x7b\x36\x99\x76\x2f\xe6\x12\x54\x93\x9f\x06\x0a\x4e\xbf\x12\xb0\x9a\xd2\xfc\xff\x29\x79\xf2\xe7\x68\x19\x6c\xb7\x06\x7d\xad\x3b\x20\x7d\xea\xc5\x26\xf3\xaf\x7b\xb1\x16\x6e\xff\xd0\x17\xeb\x0b\x5f\xf9\xb1\x09\x93\x63\x7b\xd1\x7b\x9c\xa9\x55\xc7\x13\x7e\xd2\x2f\x16\x80\xf2\x82\x48\xaa\x5b\x09\x12\x88\x21\x06\x5f\x4e\x27\x22\x0c\x4c\x77\x6c\xd8\xbf\x44\x93\xbe\x88\xb3\x2d\x11\xdb\x82\x67\x20\xe5\x4b\x9c\x65\xf8\x9c\xc6\x15\x7f\xa7\x17\xbb\xc2\xea\x64\x4b\xac\xda\xab\xbb\x74\x76\x1e\x06\x9f\x89\xd4\x34\x43\xac\x7e\x96\x92\x62\xcc\x5c\x85\xd4\x07\xda\xbf\x9b\x86\x7c\x80\xa2\x94\x63\xb2\x1a\x15\xba\x64\x83\xfa\x74\xd4\xb5\xef\x9b\x20\x68\x8d\xd7\x60\xe0\xcc\xf7\x97\x66\xd9\x3d\x74\x8b\xa7\x3d\x38\x3d\x9b\x9d\xce\x4e\xcf\xfb\xf5\x6e\xdd\xa2\x9a\x0e\x75\x6f\x34\x72\xdd\x29\xa9\x22\xc3\x77\x54\xf1\xc5\xde\x81\x7f\x7a\x08\xdc\x81\xd7\xd1\x04\xf5\xf3\x4c\x66\x2d\xce\xb6\x0a\x74\x80\xf8\x1c\x1c\xfe\xc5\x20\xcf\x57\x61\x37\xe6\xc5\xbf\x13\xf3\x9a\x48\x3a\xf3\x62\xc8\x51\xa9\xed\x57\x47\x5d\x10\x06\x8f\x61\xd0\x17\xe0\x1b\x20\xe4\x10\x1f\x7d\x8d\xbf\x25\xb0\xbd\x28\x86\xbf\x05\xac\xf5\xd4\xfb\xab\x64\xa9\xbe\x50\x7f\x5a\x9a\xf2\xda\x01\x21\xb4\xd2\x12\x55\xf0\x96\xe4\x68\xc9\x34\xfc\x22\x30\xcd\x69\x86\x34\x15\x5c\xf9\xfd\xc1\x08\x4b\x51\x61\xf1\xc0\xc1\x9b\x95\xb5\x78\xb8\x73\x28\x50\x03\xd2\x63\x18\x52\x5e\x2d\xf5\xaf\x7a\x5d\x91\x1f\xcd\x8b\xfd\xe6\xcf\xa5\x66\xf1\xf7\x5b\xa5\xb8\x0d\x62\xfa\xc5\x92\xaa\x7d\x3b\x15\x83\xe9\x55\xc7\x75\x6b\xe0\x33\x98\xb9\x39\xf3\x2c\x24\x5a\xef\x14\x27\xc9\x45\xb6\x6c\x86\x11\xdf\x82\x73\xd7\x4a\x9b\xdc\x01\xa3\x54\xf3\x5d\xc3\xa0\x93\x27\xa9\xb6\xc3\xb8\x4c\x70\x2d\x05\x6b\x65\x75\x59\xa2\x37\x8d\xa2\x3c\xaa\xcd\x1a\xef\xec\x71\x87\x7e\xf0\xe1\xfd\xc7\x6b\xb8\xfc\xf8\x16\xde\x5c\xde\xde\xf9\x6f\x6e\xa7\xc3\x11\xe5\xb9\x88\x9a\x0e\xaa\x1f\xf0\xc7\x4e\xda\x81\xb7\x41\xe3\x6e\x3b\x66\x61\xb5\xf0\xa6\xcb\xdd\x6c\x0d\x8c\xda\xa2\xbb\xc7\x56\x95\x88\xb1\x21\xe3\x49\xdd\x45\x7b\x75\x0e\x17\xb2\x44\xac\xe3\xea\xe6\x58\xd3\xf9\xfc\x08\xba\xaf\x78\xe4\x86\x59\x83\xee\xbb\x56\x64\xd0\xab\xef\x18\x37\x7d\xa0\xfc\x1e\xee\xf4\x9a\x51\xbe\x18\xe0\xb8\x1b\x8d\x0c\x6b\xbf\x7a\x30\x80\x49\x26\xa4\x0d\xab\xe6\xa9\x1e\xc3\x10\xb5\x78\xd9\xdd\xc3\x79\xfe\xfa\xf8\xec\xf9\x7b\x3d\x79\x7e\x21\x98\x22\x2b\x10\x51\xde\xd3\xfd\x54\xda\x9d\xfd\x12\xad\x1a\x6f\x98\x9d\xc4\xd5\xea\x60\x13\x8e\x0f\xaf\x10\x23\x1c\x23\x09\x97\x58\xfd\x77\xa9\x74\x49\xb8\x56\xa6\xa9\xcf\xb3\xa8\x20\xc8\x76\xf3\xbd\x87\xb1\xf5\xef\x63\x6f\x37\x72\x18\x59\x4c\x37\x03\x8c\x04\xef\x94\x35\x23\x6c\x1a\xf7\x33\xf2\x5b\xe9\xc1\xc4\xb9\x44\x54\x83\x9d\xdf\x6a\x38\x1c\x77\xf2\x76\xde\x7b\x7a\x72\x5a\xad\x0e\xec\xbf\x2c\xf4\xd5\x38\x3d\x39\x33\xcb\x9b\x30\x18\x59\x0f\x41\xa8\x62\xb0\x74\x3e\x12\x78\x93\xad\x26\x1c\x83\x20\x67\x02\xe9\xa4\x19\xdf\x3f\x86\x21\xc0\x2b\x26\x90\x9b\xa5\x04\x7e\x5b\x58\xf7\x84\xc1\xa0\x29\x6c\x88\xb9\xcb\x26\xd5\x93\xaf\xba\xed\x2c\xf0\x01\x71\xac\x32\x54\x11\xa0\x55\x21\x38\x81\xb9\xd5\x53\xa1\x52\x2d\xf9\x02\x16\x88\xa1\xd5\xfa\x09\xbb\xcc\xed\x3b\x6e\xd9\xe5\xe4\x6c\xfe\x42\xbb\x1c\xc7\xdf\x37\xb2\xfc\x2f\x00\x00\xff\xff\x05\x40\x0f\x0a\xb4\x1a\x00\x00")
+var _assetsCssIndexCss = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x59\x5b\x6f\x1b\x37\x16\x7e\x1e\xfd\x0a\x6e\x83\xae\x2f\xf0\x8c\x34\xba\xd9\x91\xd1\xa0\xb6\xe3\x74\x83\x75\xed\xc2\xde\xa0\x0f\x45\x0b\x50\x33\x1c\x0d\xd7\x9c\xe1\x80\xa4\x62\x3b\x46\xfe\xfb\x1e\x92\x73\x23\x25\xbb\x6e\xb0\x41\xaa\x89\xa5\x88\x97\x73\x3f\xe7\x23\x8f\x86\xfb\xe8\x24\xc5\x95\x22\x29\xca\x04\x2f\xd0\x29\xe3\xc9\xad\x44\xbb\xb9\x52\xd5\x62\x38\x3c\x61\x1f\xb1\x20\x9f\x22\x2a\xf7\x06\x68\x7f\x38\x18\x0c\xf7\xd1\xd9\xd5\xc5\xd5\x35\xba\x3e\x7f\x77\x7e\x7d\x7e\x79\x76\x3e\x08\xd1\xab\x38\xd3\x0f\xda\x3d\xc5\xc9\xed\x4a\xf0\x75\x99\xa2\x33\xce\xb8\xd8\xd3\x93\x93\x54\x3f\x68\xf7\x2d\x96\x79\xb8\x2e\xa9\x42\x18\xe6\x73\xcc\x32\xfb\xed\x86\x24\x8a\xf2\xd2\xac\xcd\x70\x9c\x8e\x61\xed\x35\xc8\x13\xa2\x0f\x12\x3e\x68\x89\x24\x61\xb0\x86\x0b\x79\x80\x2a\x2c\x04\x5e\x09\x5c\xe5\xf0\x65\x89\x85\x34\xc4\xb8\xca\x89\x65\xb6\x1c\x27\x47\x93\x18\xed\xfe\x24\x08\x29\x7b\x24\x14\x55\x8c\x98\x2d\xe9\x8a\x3c\xb1\x35\xd5\x0f\xfa\x27\x48\x91\x81\x2e\xef\x78\xa9\x50\xa2\xb5\x00\xdd\xb5\xea\xfc\x16\x3d\x0e\x10\xbc\xcc\xe0\xa2\x66\x75\x3c\xf8\x3c\x18\xbc\x12\x24\xe1\x25\x4c\x07\x08\x65\xb0\x2f\xbc\x23\x74\x95\xab\x05\x5a\x72\x96\x1e\xeb\xd1\x0a\xa7\x29\x2d\x57\x21\x23\x19\x0c\xcf\xaa\x7b\x33\x5a\x53\xb2\x5a\x5b\x4a\x25\x4f\x81\xd6\x1a\x78\x5b\x66\xcd\x46\x61\x09\x1e\xea\x9d\xb0\x2e\x4a\x29\x59\xae\x95\x32\x5c\xf5\xba\x65\x6b\xfa\x05\x12\xa4\x22\x58\x85\xf7\x48\xf1\x0a\x69\x8e\xc7\xde\x92\x90\x16\x78\x45\x16\x68\x2d\xd8\xee\x10\x4b\x49\x94\x1c\xd2\x62\x35\x94\xb7\x6b\xc6\x22\xf9\x71\xb5\xb7\xb1\x43\xd2\x4f\xb0\x01\xb4\x54\x98\x96\x76\xf6\x8e\xa6\x2a\x5f\xa0\xc9\x58\xcb\xa4\x07\xf2\x5a\xeb\x6e\xa4\xc0\x62\x45\xcb\x10\xe4\x80\x51\x6f\xd0\x9a\x22\x8c\xc7\x8d\x4a\x05\x96\x8a\x08\x50\xac\x56\xa9\xa6\xff\x7a\xfe\x32\xfa\xe3\xd6\x36\x19\x17\x45\xa8\xe5\xae\x6a\x4a\xf5\xb2\x25\x07\x83\x15\x0b\x34\xb2\xcb\xf0\x5a\x71\x85\x97\xf5\x1a\xfe\x91\x88\x8c\xf1\xbb\xf0\x61\x81\xf4\x8c\xcb\x32\x9e\x1f\x35\x3c\x97\x5c\xa4\x44\x84\xe0\x3b\x86\x2b\x69\x8c\x62\xff\xb7\x4d\xbb\xb9\x95\xa9\xe5\xf5\x06\x45\xf0\xc1\x88\x23\xd8\x62\x74\xdc\x46\x8e\xb5\x73\x3c\x6a\x74\x69\x36\x42\x98\xe2\x14\xb6\x2b\xa1\xdf\xd2\x83\xde\xcc\x92\xa7\x0f\xdd\x8c\x1b\x37\x9a\x74\x27\xf4\xa2\xe4\xa5\x23\x66\x33\x6d\x03\xf1\x2e\xa7\x8a\x58\xb6\x2a\xa7\x65\x4d\xc2\x23\x88\xc0\xf8\xe8\x1f\xb4\xa8\xb8\x50\xb8\x54\x0e\xb5\x8d\x39\x20\xf5\xa3\xfd\x8a\x76\x74\x49\x91\x50\x53\x12\x88\xf0\x28\x07\xb3\x26\x39\x16\x4a\x46\x09\x2f\x86\x89\x94\xc3\xfe\x90\x94\x3b\xc7\x20\x45\x37\x04\x2e\xe6\x4c\xd1\xea\x8d\xac\xf0\xd6\x90\x5f\x2d\xf1\xee\xe1\xeb\x03\xfb\x6f\x14\x1d\xcd\xf6\x1c\xc5\xd1\xa8\x71\x5f\xab\xc7\x68\x5b\x10\x85\x71\x3b\x6c\x6d\x82\x7a\x46\xd1\x8e\x86\xa4\x93\x5e\x6a\x9a\x8d\x87\x1e\xfd\x26\x61\x5b\x4f\x0e\xf7\xdb\xd7\xe9\xc9\xcd\x39\x94\xd1\xcb\x77\xef\x7f\xfa\x70\x7d\xf2\x9f\xf7\x57\x97\x37\xdd\xe4\x10\x68\x0c\x8c\x4b\xa1\x9c\xf4\x54\xac\x8b\xac\x66\x62\x02\x25\xc3\x05\x65\x10\xab\x3b\x57\x15\x54\xba\x1b\x5c\xca\x9d\x03\x24\xe1\x23\x94\x44\xd0\xcc\xf0\xcc\x63\x20\xe2\xae\xbe\xc6\x8c\xdc\xe1\x07\x77\xad\x5e\x3a\x39\xc8\xa7\x07\xf9\x4c\x73\x7d\x19\xfd\xa0\x5f\xe9\x98\x7e\x27\xc2\x72\x1d\xb7\x44\x4c\x34\x8f\x9b\x0c\xcf\x27\xee\xc4\xd4\x64\x47\xe0\xd4\x53\xb3\xce\x48\xe1\x95\xd9\xa0\x9f\x58\xb3\x9a\x22\xc3\x4b\xc2\x9c\xb2\xdc\xf7\x57\x89\x3f\xbe\x61\xf4\x0d\xde\xba\xc0\x8f\x69\x2f\x05\xbb\x0c\x34\xd5\x98\x88\xad\x75\x36\x75\x4a\x82\xc0\x29\x5d\x4b\x88\xac\xe8\x88\x14\x76\x26\x2c\xf8\xa7\xf0\x99\xe9\x3b\xb2\xbc\xa5\xea\x99\x15\xb5\xd0\x1a\x93\xe0\x65\xc7\x52\x2a\x2b\x86\xc1\x37\xb4\x64\xb4\x24\xe1\x52\x83\xb6\x9d\xda\x82\x3e\xbd\x10\xaf\x63\x72\xd6\xc4\xaa\x22\xf7\x2a\xc4\xe0\x3b\x48\xdd\x84\x94\xc6\x81\x5b\x62\x78\xec\xc7\xb6\x2d\x6e\x6d\xe1\x16\xfc\x0e\x4a\xcf\xfe\x6f\x09\x03\x2c\xf9\xe3\x87\xef\x40\xe6\xf0\xbb\xdf\x17\x19\x15\x52\x85\x49\x4e\x99\x57\x94\xec\xfe\xd1\x36\x5e\x23\x3f\x57\xf6\x4f\x2f\xae\xce\xfe\x7d\x03\xb0\xfc\xeb\xf5\xc9\x2f\xbd\x3c\xb1\xa7\x91\x30\x0c\x91\x3e\x58\xa0\x0f\xfa\x28\x31\x6c\xf1\x5e\x8f\x2d\x39\x16\x29\xd0\x5f\x11\x04\xcb\x60\x7d\x94\x36\x47\x90\xda\xe0\x16\xb1\x7b\x28\xd7\x58\xdb\x9e\x59\xdc\x0a\xa2\x23\x02\x49\xce\x68\xea\xce\x7b\xe0\xd2\x05\x4e\xcb\xad\xd6\xbe\xc1\x92\xf1\xac\xad\x31\x35\xc4\x4d\xa7\xee\xa6\x94\xaf\x01\x23\x96\x74\xe5\xed\x9c\x4d\x46\x5b\x8a\x6f\x83\x93\xf1\x68\xb3\xfa\x6e\xa8\xbc\xc8\x35\xd0\x3d\xa9\xf8\x34\xd3\x8f\xc9\x85\x0e\xe7\xfc\x99\x3a\xa8\xef\x43\x99\xe3\x94\xdf\x2d\xb4\x10\x80\xee\xe6\x4f\x23\x80\x96\xe3\x55\x3c\xd3\x8f\x1f\xe6\xdd\x9e\x67\x37\x78\xc4\xd1\xb3\x1c\x5c\x5b\xa7\xe6\xa8\xe7\x16\x9a\x38\x36\xf9\x6d\xc2\x5d\x09\xa8\x61\xfa\x84\xb0\x58\x57\x15\x11\x09\xd6\xc0\xdd\x14\x9b\x26\xcb\x5c\x92\xb9\x4d\xfe\xa0\xc5\x92\xe3\xee\x9b\xad\xfe\x71\x17\x1a\x9d\x1a\xbd\x15\xa1\x54\x0f\x0c\x70\x5d\xd3\x24\xf6\x44\xd8\x43\x9d\x49\x5d\x7d\xdc\x48\x3a\xdc\x7e\x5e\x1a\xcf\xbc\xf1\x3a\x49\xeb\x09\x4f\xf2\x78\xb3\x9e\x6f\x45\x00\xb7\x9a\x4f\x46\xa3\xe3\xbe\xfd\xd0\xd8\xc4\x67\x60\xaa\x4d\x1b\xc6\x76\x88\x28\x28\x1b\x21\xc0\x72\xd2\xa1\x6a\xe0\x57\xad\xa0\x8f\x95\x36\x43\x02\xa7\x18\xd8\x13\x71\xcf\x26\xe3\xce\x63\xb6\x40\x35\xf5\xc9\x53\x70\xfc\x85\x80\xd5\x1c\xcd\xff\x4c\xc9\xf9\xb7\xd1\x32\xd8\xbc\x1a\xf4\xb5\xee\x80\xf4\x29\x8f\xc5\xb3\x2f\xf3\x58\x0b\xb7\xff\x57\x8f\xf5\x85\xaf\xdc\xdc\x44\xf1\xd4\x6c\x74\x9c\x33\x36\xea\x38\xc2\xc7\xfd\xc3\x02\x94\x78\xb8\xb6\x51\xd5\x4a\x00\x6a\xc0\xe3\xca\x69\x45\x44\x9e\xe9\xa6\x9a\xfd\x4b\x34\xe9\x8b\x38\xd9\x10\xb1\x3d\xf0\x78\x52\xbe\x24\x58\x7c\x77\xea\x50\xfc\x8b\x51\x6c\x0f\x56\xf3\x0d\xb1\xea\xa8\xee\xca\x19\x7c\x81\x7a\xaf\x68\x82\x59\xed\x96\x82\xa6\x29\xb3\x27\xa4\x3e\xd0\xfe\x4b\x5f\xc8\x3d\x14\xa5\x65\x4a\xee\xa3\x5c\x15\xcc\x3b\x9f\x46\xdd\xf5\xfd\x31\x08\x5a\xe3\x35\x18\x38\x71\xe3\xa5\x19\xb6\x8e\x6e\xf1\xb4\x07\xa7\x47\x93\xc3\xc9\xe1\x71\xff\xbc\x5b\x5f\x51\xf5\x0d\x75\x27\x8a\xec\xed\x94\x54\xa1\xe6\x1b\x55\xe5\x6a\x67\xcf\x5d\xed\x03\x77\xe0\xdc\x68\x82\xda\x3d\xf1\xa4\xc5\xd9\x56\x81\x0e\x10\x9f\x83\xc3\xbf\x19\xe4\xb9\x2a\x6c\xc7\xbc\xd1\x5f\xc4\xbc\x26\x93\x8e\x9c\x1c\xb2\x54\x6a\xfb\xd5\x59\x07\x0e\xff\x3c\x08\xfa\x02\x7c\x05\x84\xf4\xf1\xd1\xd5\xf8\x6b\x02\x5b\xfc\xad\x60\xad\xa7\xde\xdf\xa5\x4a\xf5\x85\xfa\x66\x65\xca\xb9\x0e\x70\xae\x24\xc4\x73\x85\xde\x92\x0c\xaf\x99\x42\x3f\xf3\x94\x66\xb0\x51\x37\x11\xa5\x7b\x3f\x88\x52\xc1\x2b\xc8\xa8\x12\x39\xbd\xb2\x16\x0f\xb7\x36\x05\x6a\x40\x02\xbe\xb4\xac\xd6\xea\x37\xf5\x50\x91\x1f\xb4\xc7\x7e\x77\xfb\x52\x93\xd1\xf7\x1b\x47\x71\x93\xc4\xf4\x93\x21\x55\xc7\x36\x0c\x1d\x3f\xc1\x75\xa3\xe1\xe3\xf5\xdc\xac\x79\x56\x02\x3f\x6c\x15\x67\x91\xf1\x64\xdd\x34\x23\xbe\x06\xe7\xee\x2a\xad\x6b\x07\x8a\x96\xaa\xdc\xd6\x0c\x9a\x3f\x49\xb5\x6d\xc6\xe9\xbe\xa1\xe0\xac\x95\xd5\x56\x89\x5e\x37\x0a\x62\xba\x36\xeb\x68\xeb\x1d\xd7\x8f\x83\x8b\xf7\x97\xe7\xe8\xe4\xf2\x2d\x3a\x3d\xb9\xbe\x71\x7d\x6e\xba\xc3\x21\x2d\x33\x1e\x36\x37\xa8\x7e\xc2\x4f\xad\xb4\x5e\xb4\xa1\x26\xdc\xb6\xf4\xc2\x6a\xe1\xf5\x2d\xf7\x71\xa3\x61\xd4\x1e\xba\x7b\x6c\x65\x81\x19\xf3\x19\xc7\xf5\x2d\xda\x39\xe7\x94\x60\x1c\xcc\x3a\xae\xb6\x8f\x35\x9e\xcd\x0e\x50\xf7\x36\x8a\x6c\x33\xcb\xbb\x7d\xd7\x8a\x78\x77\xf5\x2d\xed\xa6\x0b\x5a\xde\xa2\x1b\x28\xaf\xb0\xcc\xc3\x71\xdb\x1a\xf1\xcf\x7e\x75\x63\x40\x37\xa2\x85\x49\xab\xc6\x55\x40\x17\xb7\x78\xd9\xed\x4b\xb3\xec\xf5\xf4\xe8\xf9\x7d\x3d\x79\x7e\x26\x29\xc5\x46\x20\x22\x1d\xd7\xfd\x58\x98\x99\xdd\x02\xdf\x37\xd1\x30\x99\x83\x42\x7b\x8f\xb0\xfd\x0c\xaa\x7b\x99\x62\x81\x4e\x52\xf9\xdf\xb5\x54\x05\x14\x4e\xa9\x2f\xf5\x59\x12\xea\xde\xa8\x96\xa9\xe7\x18\x73\xfe\xfd\xdc\x9b\x0d\x2d\x46\xe6\xe3\x47\x0f\x23\x91\xb3\xca\x98\x11\x3d\x36\xe1\xa7\xe5\x37\xd2\x23\x9d\xe7\x02\x43\x19\x34\xfd\x5b\xa5\x7f\x0d\x69\xe5\xed\xa2\xf7\x70\x0e\xd7\xb6\x3d\xf3\xcb\x42\x5f\x8d\x43\xdd\x3e\xde\xd3\x35\x3d\x32\x11\x82\x71\xc5\xd0\xda\xc6\x48\xe0\x74\xb6\x9a\x74\x0c\x82\x8c\x71\x0c\x15\xbc\x6e\xdf\x83\x10\x08\xbd\x82\x21\xdb\x4b\x09\xdc\x6b\x61\x7d\x27\x0c\xbc\x4b\x61\x43\xcc\x6e\xd6\xa5\x9e\x7c\xd1\x6e\x6b\x81\x0b\xd0\x4a\x26\xb8\x22\x88\x56\x39\xd8\x05\xcd\x8c\x9e\x12\x17\x72\x5d\xae\xd0\x0a\x33\x7c\xff\xf0\x84\x5d\x66\xc6\x8f\x1b\x76\x99\x1f\xcd\x5e\x68\x97\xe9\xe8\xfb\x56\x96\x88\x08\xc1\x45\xf7\x2b\x49\x0b\x5a\xed\x65\xe9\x7f\xc4\x15\xa7\xe7\xd7\x1a\x00\x00")
 
 func assetsCssIndexCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -109,7 +109,7 @@ func assetsCssIndexCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/css/index.css", size: 6836, mode: os.FileMode(509), modTime: time.Unix(1482287883, 0)}
+	info := bindataFileInfo{name: "assets/css/index.css", size: 6871, mode: os.FileMode(509), modTime: time.Unix(1792314671, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -174,7 +174,7 @@ func assetsImgSkullSvg() (*asset, error) {
 	return a, nil
 }
 
-var _assetsJsIndexJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x39\x6d\x6f\xdb\x38\xd2\x9f\x1f\xff\x8a\xa9\x5a\xac\x24\xc4\x51\x5c\xec\x3e\x5f\xbc\x75\x0e\x6d\x52\x74\x73\x9b\x4b\xd2\x3a\x45\x71\x17\x04\x05\x2d\x8d\x6d\x5e\x69\x52\x25\xa9\xb8\x41\x91\xff\x7e\x18\x92\x92\x28\x27\x69\x7b\x8b\xc5\xe2\x0a\x14\x91\xc9\x99\xe1\xbc\xbf\x90\x37\x4c\x43\xa9\xa4\x1c\xd1\x87\x54\x15\x1a\x98\xc1\xd7\xbb\xfe\xe7\xc7\xc5\xed\x47\x5e\xc1\x0c\xae\xae\xdd\xe2\x86\x71\x59\xae\x99\xb6\xee\x97\xfb\xda\x41\xe1\x72\xa9\x3c\xfc\xe8\xe0\x00\x8e\x04\x32\x0d\xaa\xb1\x60\xd7\x08\x42\xad\xa0\x54\x8d\xb4\xa8\x61\xa9\x34\xd8\x35\x37\x0e\xa9\x18\x2d\x1b\x59\x5a\xae\x24\x94\x84\x71\xe4\x81\x32\x5e\xe5\xf0\x75\x04\x1d\xd2\x0c\x9e\x65\xc9\x53\xa1\x56\x61\x61\x3f\x81\x3d\xe0\x15\xe4\x3d\x4c\x61\xf1\x8b\xcd\x26\x83\x15\xb5\x5a\x09\x3c\x12\xcc\x98\x2c\x5d\xf3\xaa\x42\x99\x8e\xc1\xea\x06\xf3\xd1\x9d\x63\xf3\x95\x56\xac\x2a\x99\xb1\x9e\xa5\x52\x6d\x36\x4c\x56\x3d\x53\x16\x85\x78\x7d\x83\xfa\x56\x49\xcc\xb6\x6b\x5e\xae\x3d\x5f\x24\xb4\x40\x09\xb3\x58\x5b\x85\x40\xb9\xb2\xeb\x11\x38\x19\x33\x82\xe1\x30\x83\xc9\xaf\xc0\xe1\x05\x81\xff\x0a\x7c\x6f\xcf\x13\x00\xe0\x4b\xc8\x22\xe4\x2b\x7e\x9d\xc3\x57\x38\x38\x80\xf9\x27\x5e\x43\x85\x02\x2d\x56\xa0\x24\x1a\x07\x0e\xce\x5e\x85\x41\x59\x79\x46\x60\x0f\x12\x20\x35\x0c\x89\x14\x92\x6d\x30\x77\x28\x77\x23\xfa\xef\x05\xfd\x8d\xc9\x4a\x20\xd4\xa8\xf7\x09\x1e\x6a\xc1\x6e\x0f\x8c\x55\x35\x2c\x1a\x6b\x49\x52\xd2\x15\x97\xab\xc8\x22\x7e\xe7\x42\xb0\xdb\x0b\x8d\xc6\x74\x46\x21\xc1\x02\x16\xd9\x25\x7d\xea\x7f\x7c\x24\x9a\xfb\xa9\x33\x4c\x1e\xc0\xca\x0d\xf9\x50\x32\xbf\x7c\xf9\xee\x32\x19\x91\xd0\x99\x07\x2e\xd6\xcc\x04\xc3\x2c\xac\xdc\x37\x4d\x59\xa2\x31\x69\x1e\x74\x40\x87\x72\xb9\x1a\x03\xc9\x0b\xc4\x67\xe1\x44\xea\xe8\x9d\x5f\x24\x23\x2f\x61\xaf\x16\xda\x7c\x50\x29\x55\xab\x15\xaf\x8b\x4b\x14\x02\x98\x10\xc1\xf1\xad\x02\xa2\x07\x4a\x83\xe3\x13\x1a\xc3\xe5\xaa\x75\x08\x21\xd0\x69\x23\x72\x54\x25\xc4\xdc\x32\x8b\x19\x7d\x8d\xc1\xd0\xf7\xff\x82\x5b\x24\x47\xe7\xa7\xa7\x24\xbc\xe3\xe8\x5b\xfe\xd1\xed\x91\x04\xf7\x9d\xe5\xd2\xc5\x8d\x8b\x5c\x4f\x4a\x2d\x1f\x57\x47\x08\x32\x25\x44\x30\xed\x18\xd6\xca\xd8\xb1\xa7\xed\xa4\xe2\xcb\xec\x59\xd8\xcc\xbf\x63\x78\x56\x5a\x7e\x83\x63\xa8\xd0\x7d\x31\x8b\xa3\x07\x84\x74\xf6\x22\xfe\xe9\xa4\x7b\xc2\xdc\x01\x0a\x83\x41\x9f\xf7\x30\xc9\xc2\x8f\xa3\x06\x0d\xfc\xce\x85\x30\xce\x47\x74\x23\x25\xb9\x83\xd3\x62\x14\x1c\x15\xc7\x41\x40\x38\xb5\xce\x20\x61\x42\x78\x47\x87\xcc\xde\xd6\xa8\x96\x94\xa6\x9e\xcc\x66\x90\x36\xb2\xc2\x25\x97\x58\xa5\xad\xad\x03\xce\x43\xbe\x7a\xcf\xb9\x93\xe3\x93\xd7\xde\x9c\xad\x27\xf7\x0e\xb9\x66\x72\x85\xd5\x22\x33\x28\xda\xb4\xd9\xa3\xbd\x72\x58\x06\x45\x71\xc3\x44\x9f\xf9\xe6\x14\x58\x64\xe1\x90\xf3\x60\xc5\x6f\x50\x42\x2d\x1a\xe3\x96\x1d\xb0\x37\x3c\x02\x97\xb5\xcb\xe4\xcc\xc2\x9a\xb9\x7d\x22\x11\x30\x53\x03\x27\xc7\x3d\x37\x74\xec\x31\xb3\x2c\x4e\x97\x8f\xa5\x2e\x4a\xea\xf4\xd7\x83\x12\x83\x59\xde\x32\xf8\xb2\xaa\x80\x81\xc4\x2d\xf8\x84\xc5\x24\x8a\x48\xff\x67\xb8\x3d\x53\x15\x66\x15\xb3\x6c\x0c\xbc\x1a\x83\x46\xa1\x58\xb0\x08\xad\x16\xae\x7e\xf1\x6a\x04\xbb\x1a\x86\x99\x03\x18\x01\x79\xdc\x3f\x58\x4d\x62\x92\x5a\x5d\x2e\x38\x39\x4e\x4d\x8b\x72\xe5\xe8\xd0\xd6\x75\x4b\xea\x59\x61\x71\x53\x0b\x66\xd1\x64\x09\x01\x5d\x86\x9f\xc9\x38\x58\x75\xc3\xf4\xa7\xa6\x9e\x42\xf2\x74\xb0\x4d\x16\xcd\xfd\x91\x27\xd2\xa0\xb6\xd0\x12\x22\xaa\x59\xf2\x74\x21\x54\xf9\xe9\x94\x1b\x9b\xe4\x05\xab\x6b\xd2\x96\xa3\xf7\xac\xd0\x28\x2b\xd4\x45\x4c\xce\xc9\x4d\xe4\x02\xc9\xf7\x75\x45\x81\x4a\xc6\xb2\xca\x32\xe1\x6b\xa0\x13\x8c\x04\x19\x01\x18\xb4\xa4\x30\x93\x05\x0c\xd2\xaf\xb3\xbf\xab\xea\x10\x6a\x7a\x50\xcf\x19\x6e\x8f\xe8\x77\x77\x4c\x84\x20\xd4\xca\x04\x0f\x77\xea\xa1\xdf\xad\x47\xf7\x09\xb0\xdb\xea\xd3\xdf\x8f\x25\x40\x00\xee\xd4\x73\xaa\x56\x19\x99\xb5\x23\x44\xa9\x30\x4a\x55\x8e\xa7\x7f\xa1\x56\xae\xcb\xc0\x2f\x16\xb5\x6c\xe5\x46\x4d\x02\xed\x34\x14\x1e\x63\x5e\x6a\x25\x84\x13\x82\xac\xbd\x50\xd6\xaa\x4d\x88\x61\xe3\xf6\xe2\x76\xc3\xaf\x84\x6e\x83\x28\xb4\x20\x85\xff\xb8\x54\x75\xd6\x2d\xad\x90\xfa\x8f\xb0\xf3\x1b\xf2\xd5\xda\xe6\x41\x51\xb1\x6f\x92\xb5\xd3\x62\xa5\x79\x95\xe6\x05\x37\xca\xaa\x1a\x33\x48\x3d\xc8\x89\xc5\x8d\x49\xa1\xdf\xc8\x83\xb0\xcf\xb2\xd4\x79\xe3\xbe\xcf\xb7\xb3\xc4\x2a\x25\x2c\xaf\x93\xeb\x34\x2f\xc2\x77\xf6\xb5\x42\xc1\x6e\xa7\xf0\x15\x12\xb3\x56\xdb\x64\x0a\xcf\x27\x93\xc9\x18\x92\x35\xaf\x30\x99\xc2\xe4\x0e\xa8\xff\xe1\xab\x15\xea\x29\xa4\x6b\x75\x83\x3a\xbd\x6b\x23\xee\x1d\x6e\xd4\x0d\x02\xf3\xb1\xe6\x8c\xc5\x28\x11\x57\xce\x87\xfa\xd0\x7b\xa3\x24\xba\xd8\x6b\xf3\xdf\x23\xf2\x10\xb5\x74\xec\x54\x49\x04\x5a\x2d\xf6\x40\xa9\x60\xb7\xaa\xb1\x29\xc9\xe8\x2b\xdb\xbd\x48\x3d\x38\x80\x63\x25\x53\x1b\x92\x1c\x70\x59\xf1\xd2\xf9\x73\x8c\x70\xe5\x42\xb4\x5f\xec\x7d\x79\xe8\xf8\x5e\xce\x37\x68\xef\xd5\x36\xb9\x32\xb0\xd4\x6a\xe3\x37\x50\xdf\xa0\x06\xca\x8a\xcc\x65\xa1\x45\x23\xcb\x75\x17\x4c\xc0\x2c\x91\x51\xb2\x8c\x94\xf2\xb2\xaa\xc2\x29\x8f\xa9\x24\xd4\x77\x8b\x9b\x39\x52\x15\x55\x64\x02\x07\xb4\x4f\x8b\xe9\x98\x84\x6d\x0c\x19\xc0\x60\xcd\x34\x31\x57\x52\xa5\x74\x96\x88\xf1\xc6\xa0\xec\x1a\xa9\x8d\x66\x12\x8a\x52\x89\xfd\x90\x78\x8c\x92\x9a\xcc\xdf\xb5\x06\xa2\xd9\xc8\x0f\xbc\xb2\xeb\x29\xfc\xf2\xcb\x64\x1c\xd6\x57\x8d\xb5\xe4\x00\x3f\x4f\xfa\x78\xca\x5d\x6e\x5b\xa1\xfd\xfb\xfc\xfc\x2c\x83\xc4\x29\x27\x19\x43\x2b\x60\xe6\x02\x11\x1e\x09\x74\x5f\x1d\xff\xeb\x48\x8f\x93\xb8\xa7\x71\xc5\xaf\xc7\xc0\xfb\x38\xf7\x31\x8b\xd6\x0d\x20\xde\xae\xfe\xc4\x8e\x8b\xcf\xb5\x71\x9c\x51\x1d\xd5\x1b\x57\x79\xe8\xf7\x55\x12\x36\x92\x90\x35\xba\x09\xa6\x30\xa8\x39\x9a\xab\xc9\x75\x61\xd0\x3a\x84\x00\xea\x01\x05\x33\xf6\x72\x0e\xb3\x96\xf2\x55\xf8\x1b\xc4\xdb\x7f\x7e\x7d\x35\xb9\xfe\x63\x71\x3c\xac\x6d\x34\x15\x6d\xcc\x8a\xd2\x90\x2b\xb2\x55\xea\x23\xcd\xa4\xc6\xed\x2d\xd4\x97\xa8\xe0\x0d\xb3\xe2\xc6\xac\xfa\xde\xe3\x07\xf2\x16\x25\xa1\x0e\xec\xa7\x9f\x3a\x94\xab\xc9\x75\x6c\x52\x66\x5f\xb9\x84\x08\xb3\x87\x32\x5d\x0e\x7b\xf0\x1c\x0e\x67\x31\xf6\x20\xdd\xc1\x7e\x8f\xa6\x1a\x8b\xda\x2f\x67\x79\xef\x33\xa4\x5c\xfc\x62\x23\x56\xdb\x59\x8e\x9a\x02\xbb\x50\xd5\x2d\xd8\x0a\x4c\xcd\x64\x92\x17\x04\x9e\xe5\x7e\xb4\xcb\xbb\xfe\x98\x94\x36\x9b\x75\xb4\xda\x06\x99\x6d\x70\x0c\xff\x6e\x8c\x05\x2e\x4b\x8d\x1b\x94\xb6\x08\x8e\xe6\xe7\xdd\x9d\x59\xf2\xc1\x93\xfb\x43\x97\x5c\x56\x59\x5a\x04\xb4\x34\xef\x82\x2a\x1a\x37\xcf\x9a\xcd\x02\x75\x36\x58\xcb\x9d\x9a\x72\x5f\xe8\x03\x1f\xbb\xb8\x0f\x0e\xa6\x4b\x26\x4c\x3b\xba\xc5\x2d\x2c\x38\x2f\x99\x41\xfa\x82\xb4\xe2\x73\xc2\x2c\x69\xc5\xf1\xe8\xc9\xe1\xf3\x17\x07\xb4\x7d\xe8\x80\x0e\x69\x0c\x23\xac\x3d\x48\xc3\x7a\x1a\x68\x3d\x2a\x7c\xdf\x76\x24\x2f\xac\x3e\x7c\x61\xab\xc3\xa4\xa3\x92\xbc\x38\xb0\xd5\xe1\x8b\x03\xab\x0f\x93\x56\x13\x64\x8a\xd6\x61\x82\x0d\x7e\x47\xac\x81\x86\x82\x35\x86\xd2\xda\x5a\xe0\x0f\x95\xce\x3e\x0b\x74\xb9\xe0\x64\xe9\x62\xc3\x97\x28\x6e\xa8\x34\xa8\x1a\xe5\xb8\xb7\x39\x70\x6b\x3a\x4d\x77\x3e\xf3\x84\x06\x55\x27\x77\x98\x50\x0b\x6e\xb2\x74\x7a\xc3\x0d\x5f\x08\x74\xf3\xc7\xd0\x48\xdf\xbc\x73\xf8\xeb\x1c\xa1\x1d\x4a\xdc\x3d\xcb\xdb\x8b\x79\xc8\x75\x5f\x7d\x22\x71\x77\x13\x60\xb0\x54\x34\x26\x23\x92\xa8\x5b\x84\x35\xa3\x3a\x2e\x7d\x95\x28\xd5\xa6\x76\x55\x91\x50\x6b\xc5\xa5\xa5\x8c\x43\x85\xcd\x2a\xa2\x41\xb6\x7a\x7f\xe2\xaa\x5d\x9f\x64\x0b\x7f\x60\x97\x0b\x27\x24\xc5\xa9\xbb\x28\xe1\x1b\x34\x96\x6d\x6a\x3a\x88\x55\x15\x56\xc5\x60\x16\xc1\xf2\xd3\xdb\x8b\xb9\x4b\xab\x5e\xa7\x5d\x29\xb0\x06\xb8\x6c\x65\x88\xe7\x5d\x6b\xa8\x3a\xb8\xa3\x7a\x33\x84\x52\x1e\xc0\xaf\xac\x71\xcd\xc0\xb9\xa0\x96\x90\xdb\x72\x0d\x3c\x44\xf7\x4e\xac\x10\xc1\x08\xc9\xc7\x2f\xa5\x0b\x4a\xad\xae\x1d\xcc\xda\x61\xf3\x8d\xb2\xc0\x60\xd9\x08\x41\x03\xf5\x93\xce\x53\xfb\x52\xc1\xaa\xea\x82\x34\x96\x5d\x05\x03\x5b\x93\x8f\x63\x9e\x0a\xd3\x6c\xae\xf3\x0e\x93\x9c\xec\x73\x6d\x7c\x0b\xbe\x50\x82\x4a\x83\xf3\x85\x1d\x14\xe7\x14\xef\x2f\x5c\xcf\xfe\xfe\xa4\x43\xef\xd4\x6d\x4d\xb7\x76\x5f\x0f\xf7\x02\xe3\xce\x77\x39\x97\x7c\x83\xaa\xb1\x59\x6c\x83\xb1\xeb\x00\x5d\xdd\xe9\x8d\x14\x69\xa2\xab\x21\x41\x4d\x30\xf9\x2b\xae\x31\x98\xd0\xc8\xaa\xdb\x87\xaf\x33\x1a\x69\xf7\xf6\x06\xb2\x69\xb4\x8d\x96\x7e\x6b\x30\xf9\xf6\xad\x5d\x68\xba\x7c\xa7\xe9\x00\x93\xa0\xf9\xc4\x41\x4c\xbb\xab\x90\xd6\x05\x42\x29\x3e\x46\xcd\x6f\xfc\xe0\x54\x6b\x55\xa3\x86\xf7\xef\x4e\xc3\x25\x25\xc2\x16\x17\x46\x95\x9f\xd0\xba\x11\xd6\x5f\x7d\x44\x35\x79\x6b\xde\x6b\x11\x29\x51\xc0\x0c\xb6\x5c\x56\x6a\x5b\x08\x55\x32\x77\x51\xd2\xb1\x9f\x65\xa2\xa8\xb5\xb2\xaa\x54\x02\x66\xb3\x19\x24\x6b\x6b\x6b\x33\x4d\x72\xf8\x1b\x24\x5b\x63\xa6\x07\x07\x09\x4c\xe9\x93\xbe\x28\x79\x88\x22\xdc\x52\xa4\x07\x5b\x93\x0e\x44\x9f\xa3\x6d\xea\x0f\x1d\x7b\x7d\xdb\xf9\x54\x53\x36\x70\x43\xc1\xe3\xb7\x9f\x7e\x24\x27\x03\xe3\x16\x3e\xe0\x62\xee\xa8\x64\x41\x9e\x76\xbf\x50\xb2\x14\xca\x20\xcc\xfa\x36\x10\x6f\x6c\x34\xc6\x7c\xe7\xb0\x28\x91\x51\xd7\x63\x69\xe2\x75\xd9\xc7\xa1\x91\x3e\xdd\x5e\xe4\xbb\x43\xb1\x5a\xef\x8d\x2e\x43\x94\xa4\x74\xff\x08\x47\x2e\xa6\x55\x35\x30\x56\x58\x6f\x87\x1b\x79\x0b\xf8\x85\x1b\x1b\xdd\xe9\x0c\xfb\xd9\x07\x5d\xfe\x87\x47\xd7\x3f\xea\xf6\x10\x4d\x55\x8f\x54\xbe\x6e\xd6\x2d\x95\xb4\x8c\x4b\xd4\x1e\x39\xba\xbe\xef\x7e\xc6\xd7\xf7\x2e\xaa\xa2\x0b\xfb\xd6\x1a\x61\x9c\x77\xf0\x4f\xba\xa0\x25\x25\x1c\xc2\x24\xb0\xfd\x72\x49\x95\xf0\xc4\xf7\xb0\xb0\xe4\x92\x9b\x35\x1a\xf0\x17\x12\x5c\xae\xa2\xf6\xca\xe7\x3c\x9f\x40\x76\x9a\x63\x25\xb3\x4e\xcc\x30\x17\x1e\x85\xa2\x94\x8e\xbb\x9d\x7e\xd2\xc0\x1b\x94\x76\x0c\x1e\xd2\xb7\xd2\xd0\xeb\x98\xfe\xf9\xc3\xf6\x66\x03\x98\xd8\x5c\xbd\x39\x3c\xe8\xe1\x8c\xac\x35\x24\x32\xe4\x71\xb9\xcc\x76\x79\xcb\x07\xc0\xfd\x84\x17\x2d\xdf\x8d\x76\xbf\x86\x0d\x9c\x73\x3d\xd7\x1a\x4b\xb5\x6d\x7b\xa1\x1d\x4a\x77\x43\xff\xde\xa0\x31\x6c\xf5\x58\xd0\xb9\xc7\x18\xd7\x0e\xd2\xa0\x56\xd4\x4c\x1b\xa4\xfd\xa2\x1f\x60\x9c\x97\x56\xad\x2b\x5f\x6d\xcc\xca\xcd\x56\xde\x13\xcc\xd6\x15\x4f\xea\xa1\x0b\x7b\x5b\x63\x4b\xb7\x64\x06\x21\x3d\x7b\xfd\xe1\xec\xfc\xf8\x75\x3a\xdd\x19\xd0\x5a\x1a\xe3\x07\xc2\xa3\xcf\x29\xf4\x6f\xa1\x91\x7d\x8a\x28\xbe\x39\x3f\x7b\x3d\x20\x19\xdf\x1e\x3c\x82\x73\xf9\xf2\xdd\x9b\xd7\x97\x6f\x2f\xe6\x2f\x2f\x3b\x34\xca\xed\x96\xe9\x15\xda\xcf\x75\xdb\xbb\xb6\x19\xde\xaf\x43\xe8\x56\xdb\x7b\xce\x87\x69\x5f\xbc\x3b\x3f\xda\xa5\x5b\x6b\x55\xee\xd2\x74\x6b\x3f\x46\xf2\xed\xc5\xbc\x23\x47\x1e\xd7\xdf\x3e\xc4\xfe\xd6\xaf\xf6\x5d\xc5\x3d\xd2\x77\x11\x57\xf7\xe4\xec\xa0\xaf\x9e\x5f\xc7\x3d\xf8\x93\xf6\xf9\x6d\xf7\xc8\x78\xbd\x8f\xfd\xfe\x94\x78\xbf\xf8\x5c\x53\x7e\xe8\xce\x88\xe9\xb7\xbd\x47\xcf\xc0\xe4\x7a\x70\xd0\x83\x00\x74\xa2\xab\xc4\x53\x98\x8c\xc1\x34\x9b\xe9\x64\x97\x81\x07\xf1\xa8\x3f\xa2\xd0\x8e\xc5\xfd\x26\x7c\xdc\x32\xdc\xb7\x8e\xbb\xf1\x7f\x7d\xdc\x59\xe8\xbb\xaf\x57\x3b\x51\xd3\x59\xdd\xbf\x5b\x0d\x0a\x5d\xfc\x82\xb1\x13\x06\x1e\xda\xcd\x90\x09\xa7\xd1\xca\x5a\x9d\xa5\x6e\x76\x4b\xc7\x90\x2c\x19\x2c\xd9\xbe\xb1\xaa\x4e\x1e\x73\xab\xf9\xe5\xf9\xc5\xc5\x5f\xc2\x78\x54\xa6\x7f\x94\x73\x3a\xf4\x51\xce\xbb\x87\x96\x6f\x71\x5f\x2a\x21\xc2\x94\x5a\xd0\x37\x75\x39\x7f\xa2\xfe\x1f\x64\xe9\x3b\x0a\xfd\xf3\x59\x1a\x6a\x76\x87\xa7\xd3\xf3\x37\x7d\xde\xd8\xbd\xdd\xf9\x76\xca\x39\x7e\x35\xff\x70\x72\x79\xf4\x5b\x24\xcd\xb3\x2c\x31\xee\x7e\x30\xf1\x0f\x29\x44\xa3\x5a\xdc\x27\xe0\x63\x2f\xf4\xa4\xa4\xb2\x76\xc2\x7c\x96\x55\xaa\x6c\x68\x48\xcd\x0b\xd7\xa7\x64\x5d\xfd\x69\x9f\xf1\x20\xf3\xdd\xed\x55\xd2\xf5\x8c\x49\x97\x08\x86\xad\x5b\xd6\xb5\x7d\x17\xae\x65\x69\x1f\x0e\x83\xd2\x4c\x7b\x4b\x40\xcd\x8c\x56\xa2\x7d\xdf\x71\x92\xc4\x4f\x2c\x84\x79\xef\x89\x25\x7e\x64\x19\x00\x78\x09\xfb\x32\x48\x9b\xae\xed\x69\x2b\xef\x14\x52\x56\xdd\xa0\xb6\xdc\xa0\x4e\xc7\xa3\xff\xa3\xd5\xa3\x29\xa4\x25\xdb\xd4\x8c\xaf\x64\xbb\x76\x3c\x85\xb4\xc2\x1b\x5e\xe2\x47\x4a\x39\xed\xf2\xe9\x14\xd2\xb6\xb5\x1f\x6c\x5c\x4e\x21\x75\xad\x46\xbb\xda\xeb\x9a\x92\x39\x31\x12\x24\xdf\x79\xde\x89\x1e\x78\x62\x51\xdc\xe3\xb2\xf1\x92\xe4\xbb\x3d\x5b\x3f\xa7\x8f\x20\x9e\x57\xe3\x97\x9b\xae\x25\xad\xa6\x90\x10\x44\xd2\xb6\x5a\x9f\x6b\xb3\xe6\xc6\x2a\x7d\x3b\x6d\xfb\xc3\xbb\x31\xfc\xfc\xff\x93\x7c\xb7\x1b\x7f\x68\x92\xdc\x19\xb8\x99\x40\x6d\xb3\xe4\x9f\xaa\xd1\xb0\xd0\x6a\x6b\x50\x43\xa5\xd0\x80\x54\x16\x4c\x53\xd7\x4a\xdb\x7e\xc2\x30\x45\x12\x9e\x59\xf3\xd1\x7f\x02\x00\x00\xff\xff\x9a\xed\xb2\x1e\x84\x22\x00\x00")
+var _assetsJsIndexJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x3c\x6b\x73\xdb\x46\x92\x9f\x4f\xbf\x62\x8c\xa4\x96\xc0\x9a\x86\xa4\x64\x77\x53\xe6\x5a\x4e\xc9\x92\xe2\xe8\x22\x5b\x8a\x24\x6f\x2a\xa7\x55\xa5\x40\x62\x28\x22\x02\x01\x1c\x06\x14\xad\xf3\xaa\xea\x7e\xc4\xfd\xc2\xfb\x25\xd7\x8f\x79\x82\xa4\xe4\x6c\xe5\x36\x55\x8e\xc0\x79\x34\x7a\xba\x7b\xfa\x35\x3d\xb8\xcb\x5a\x31\xa9\xab\x6a\xeb\x0e\x1e\xaa\x3a\x97\x4a\xec\x89\x4f\x0f\xee\xe7\x2f\xe3\xfb\x5f\x8a\x1c\x1a\xaf\xae\xa9\x71\x9e\x15\xd5\x64\x96\xb5\x9d\xfd\x55\x66\x9d\x6b\xa0\x27\x0f\x86\xe9\xec\x81\x2d\xaa\x69\xed\x60\x1e\x9c\x7c\xb8\xb8\x3c\x3a\xff\xe1\xe8\x67\x68\x8b\xfe\x38\x29\x17\xaa\x93\xed\x1f\x23\xb1\xbd\x2d\xde\xc3\x68\x51\x65\x73\x29\xea\xa9\xd0\x3d\x2f\x96\x05\x34\x2a\xd9\x16\x52\x59\x90\xb2\x6d\xeb\x96\x5f\x83\xf3\x4e\xb2\x4e\xaa\x4e\x50\x2b\xac\x70\x51\x01\x0a\xe3\x7b\x1a\x49\xe0\xb6\xb6\x60\xd0\x41\x29\x61\x76\xbd\xe8\x44\x37\x93\xa2\xac\x6f\x78\xa4\x6c\xc5\x14\x66\x75\xb3\x42\xd1\x84\x74\x6b\xba\xa8\x26\x5d\x51\x57\x80\x01\xcc\x38\xe0\x41\x71\x91\x27\xe2\xd3\x96\xb0\x93\xf6\xc4\x97\x71\xf4\x05\x80\xd1\x0d\x2f\x22\xf1\x5c\x00\xf1\x12\x37\x26\xed\xe4\xc7\x2e\xde\x09\x5a\xea\x9b\x9b\x52\x1e\x94\x99\x52\xf1\x60\x56\xe4\xb9\xac\x06\x43\xd1\xb5\x0b\x99\x6c\x3d\x30\x9a\x4c\xc1\xbc\xcd\x96\x95\x28\x2a\x91\x09\x1e\x26\xba\x6c\x2c\x2a\x29\x73\xd1\x4a\x55\xfc\x57\x51\xdd\x88\xba\x9a\x48\x51\x74\x03\x25\xd4\xac\x5e\x56\x1e\xe6\xad\x9c\x96\xf5\x12\xc9\x52\x4d\xee\x2d\xea\x4a\x76\x97\xc5\x5c\x02\x09\x62\x33\x32\xe6\x1e\x21\x8a\xa9\x88\x2d\xff\xae\x8a\xfc\xda\x74\x08\x11\x34\xa7\x0c\x3a\x4e\xa8\xf3\x01\xfe\xff\x30\x14\xbb\x3b\x06\xfb\x0b\x59\xe5\x80\xf2\xa4\x9e\xcf\x33\x78\xea\x6a\x22\xb6\x66\xe5\xc8\xfc\x26\xc6\x4c\xb2\xb2\x84\xd5\x74\x59\x7b\x23\xbb\xa1\x00\x1e\xc8\x3b\xd9\xde\x23\x14\xea\x07\x8c\xba\x59\x86\xab\x93\xf3\xa6\xbb\x4f\xc5\x01\x03\x55\x8e\x57\xb8\xce\x85\x02\x31\x86\xf7\x49\x31\xce\x26\xb7\x22\x53\xe2\xe8\xfc\xfc\xf4\x5c\x21\x9c\x65\xd1\xcd\xe8\x85\x0a\x65\xaa\xc8\xff\xea\xde\x7e\x53\xdc\xc1\xbc\x25\xbc\x00\x28\x08\x94\x41\xb8\x40\xb8\xac\x12\xc7\x87\x28\x7d\x05\xf0\x80\x68\xca\x82\xad\x3a\xda\x17\x3b\x8e\xc4\x38\x25\xee\xee\x1b\x39\xb4\x4b\x68\xb2\xfb\xb2\xce\x34\xb1\x69\xc7\xa8\x1b\x94\xd1\xbb\x91\xd8\x85\x51\x30\x76\x24\x78\x46\x91\x8f\x44\xb4\x28\x48\x66\xe2\xe7\xcf\x19\x7e\x82\xd4\x44\x3e\x30\x3c\xc3\x00\x00\x92\x72\x0b\xc0\xe2\x87\x2d\xa6\x3c\x8d\x05\x78\x80\xae\x7e\xb5\x78\xb6\xb7\x27\x06\x8b\x2a\x97\xd3\xa2\x92\xf9\xc0\x87\x61\x86\xec\x99\xc1\x1a\x0a\x6a\x84\x94\x56\xf3\xef\x17\xa7\xef\x53\xd5\xb5\x20\x5b\xc5\xf4\x3e\x86\x49\x89\xe1\xeb\xa5\xa6\x9b\x22\x0a\x66\x79\x0e\x42\x08\x7b\xac\xfe\x28\x9a\x62\x72\xab\x46\xb4\xcd\x14\x32\xb1\xcc\xc6\xb2\x54\xc4\xcf\x08\x76\x35\xee\x2d\x60\xb4\x27\x9b\x7a\xb2\x96\xbc\x56\x76\x8b\xb6\xa2\xbd\xa4\x3b\xa2\x24\xbd\xcb\xca\x38\x49\x01\x91\x39\x8c\xfa\xc7\x3f\x10\x90\x2f\x5f\xc4\xff\x9e\x88\xe9\xc9\x20\x50\x84\xa6\x7b\x5d\x27\xcb\xf2\x08\x05\xab\xae\x64\xbc\x9c\x15\x93\x99\xd9\x0c\xb0\x62\xfa\x3d\x74\x28\x99\xe5\x7e\x0f\x90\x4b\x29\x1a\xd8\xd6\x24\x2d\x4d\x99\xdd\x6f\xab\xae\x6e\xc4\x78\xd1\x75\x08\x15\xf7\x31\xd0\xc9\x5b\x17\xf7\x9c\xc1\xc8\x33\x02\x56\x78\x82\xa0\x67\xa1\xce\x18\x7c\xc1\x3f\x7e\x41\x98\x2f\x06\xa4\x34\x12\x3d\x6c\x32\x47\xf6\x44\x17\x97\xfb\xe7\x97\x11\x31\x38\xe6\xc1\xe9\x2c\x53\x5a\x69\x8c\xbb\xea\x85\x5a\x4c\x26\xf0\x8a\x41\x02\x6f\x40\xfd\x87\x2f\x05\x64\x86\xb4\x28\x81\x78\xa6\xc4\x76\x0b\xef\xf4\x2c\xd2\xdc\xa6\x65\x43\xfb\xd0\xd7\xf8\xb4\xb1\x91\x83\x96\xdd\x40\xb4\x75\x64\x45\x6a\x23\x34\xe4\x2e\x61\x29\x16\x0a\x35\x91\xe6\x08\x6c\x68\xa2\x85\xa7\x42\xa1\xed\xa2\x03\x3d\x14\xe3\x13\x20\x88\xcf\x1e\x03\xa2\x83\xd3\x93\x13\xdc\x05\xd4\xe1\x71\x62\x48\x53\x2d\x3e\xa4\x36\x79\x2b\xe3\x40\xdc\xa1\x1b\xdf\xa9\x75\x2c\xf4\x68\xea\x0d\xc5\xac\x56\x9d\x86\x48\xef\x06\xc2\x7e\xa9\x3b\x93\x27\x68\x9b\x01\xd0\x3b\xc0\x2c\x97\xf4\x04\x2f\x27\xd2\x3a\xec\x89\xba\xc1\x2b\x90\xd4\x02\x36\x81\xd4\xbb\xcf\x1f\x8b\x9c\x5d\x19\xac\x57\xf9\x43\x51\x96\x6a\x1d\xd9\x69\x37\x61\x3b\xc8\x30\x69\x2e\x5f\xd7\xe7\x85\x0c\x64\x8d\xec\xe7\x9e\xa3\x64\xa8\x28\x8a\x8d\x3a\x42\xcf\x5b\x27\x16\xbe\xec\x44\x87\xc7\x47\xb0\x02\x2b\x2d\x8e\xd5\xb3\xac\xba\x91\xf9\x38\x56\xb2\xf4\x39\x7c\xf8\x06\x86\x47\x11\x0a\x67\x89\x3b\x1b\x4d\x9d\x10\x32\x2f\xba\x4b\x52\x66\xb1\xd7\xee\x94\xcd\xe1\x1b\xad\xeb\xd8\x92\xc3\xdb\x50\xbf\x2a\xb5\xac\x5b\xd0\xd4\x6c\x12\x59\x39\xe7\x63\x33\x90\xbc\x0e\x04\xf0\x9d\x04\x8b\x45\x04\xf3\xc0\xa0\xb2\x00\x7b\x51\x68\xc9\x86\x9e\xb9\xac\x16\x1e\x21\x51\x27\x32\x4a\x46\x39\x7d\x99\xc2\x0f\xd4\x8a\x71\x64\xdf\xb2\x0d\x2b\xb1\xd6\x33\xcf\xba\xcc\xd0\x0f\x71\x81\xa5\x68\xc7\x80\x34\x4b\x3e\x06\x65\x46\xd6\x4b\xdb\xcb\x1e\xae\xd4\x04\x20\xb4\x7e\x57\x29\x68\xcb\xa3\x6c\x32\x73\xe6\xb9\x73\x66\xd8\xce\xbd\xea\x88\x29\xd7\x68\x0e\x74\x1f\x92\x30\x6b\x1a\xa4\x37\xbc\xfc\x55\xdd\xe0\xe4\xd7\x5a\x93\xf2\xf0\x84\xbd\x91\x2e\x25\x05\x8d\x5a\x55\xb7\x6b\x4b\x9e\x6c\x19\x40\x38\x87\xb0\x9a\x2c\xda\x56\x56\x1d\xf7\x78\x0c\xeb\x77\x3e\x84\x82\xe0\x8d\xa4\x17\x58\xc9\x44\x03\xe6\x56\xc1\x6b\x00\x3c\x88\x10\x48\x33\xee\xc1\x76\x8d\x39\x0b\x99\xdf\xb9\x68\x0b\xbb\x2a\x78\x0e\x3b\x89\xdc\xdc\x87\x28\x8e\x33\xd5\x9b\x9d\x2d\xba\x99\x1d\x82\x3f\xe6\x12\xa5\xb6\x50\x73\xb2\x32\x51\x38\xba\xa9\xeb\xd2\x8e\xc6\x1f\xe0\x76\x49\x1c\xb8\x13\x8e\xeb\x4a\xb4\x59\x4d\x5b\x37\x71\x34\x99\xc9\xc9\xad\xcc\x41\x46\x9e\x3d\xeb\x52\xe8\x09\x87\x16\x95\x92\x40\x38\xb9\x79\xbc\x19\x11\xce\x9b\x64\x16\x11\x18\x33\xc9\x40\x8a\x83\xdd\xa2\xfd\x03\x70\x17\x51\xb4\x41\x8a\xe6\x43\xf4\x84\xc8\xa0\x3b\xc6\x60\xbb\x66\xcc\x8a\xba\x58\x4b\xff\xc4\x19\xe8\x9e\x6f\xc0\x42\x49\xa2\x34\x12\xf1\x5a\xa6\x26\x2c\x69\x43\x1a\x09\xbc\x1a\xad\x65\x63\x32\xb4\xbb\x00\xf9\x35\x5a\xc7\x4e\x3d\x26\x60\xd8\x68\x3d\x5b\xf5\x50\xc3\xad\x91\x78\xbf\x98\x8f\xc1\x7b\x5f\xcb\xd5\x84\x9c\x8b\x1d\x9e\x02\x74\x1d\x3d\xc1\xd4\xc4\x8e\x34\x5c\x1a\x7d\x0e\x6f\xdd\x34\x66\xdc\x68\x1d\x63\xd7\xec\x23\x1e\xf1\xe1\xfc\x24\x56\x8b\xe9\xb4\xf8\x18\x38\x4c\xbe\x42\x02\xf3\x09\x7e\x3e\xe8\xed\x0f\xe7\xc7\xe0\x24\x37\x60\x25\xaa\x2e\x5e\xcf\xd1\x04\x3d\x4e\x86\x67\x64\x7e\xf5\x9d\xdf\x65\x80\x66\x1e\x7f\x9c\xb5\x5a\x0f\x5a\x50\x68\x7d\x17\x48\x19\x30\x2f\xda\x68\x52\xd4\x45\xf1\xcd\x40\xeb\x18\x98\x07\x61\x82\x02\x3c\x94\xbc\x84\x86\xf0\x15\x2a\xbb\x93\x81\x14\x7e\x99\x66\xbf\x66\x1f\xe3\x4f\x8b\x16\x64\xc9\x2d\x3a\x31\x2e\x73\x74\xf6\x01\x6d\x26\x8a\xc8\x28\x90\x61\xb4\xa0\x10\x55\x55\xdd\x25\x8f\x03\x0d\x58\x16\x93\x0c\xdf\xb2\xfd\xab\xaa\xab\x48\x2b\xb5\x34\x47\xdf\x6f\x25\xe4\x59\xbb\xae\x56\xce\xeb\x3b\xb9\x71\x69\xd1\x05\x60\x9f\x0b\x24\xf9\x06\xfa\xfa\xda\x34\x9d\x02\x21\x63\x9f\xa6\x3d\x6a\x43\xd4\x1a\x92\xe2\xb7\x23\x74\x00\xee\x3b\x7a\x40\xe0\x8b\xa6\x29\x6b\xb0\xf5\xf4\x8c\xb6\xf1\x75\x91\x47\xd6\xd3\x8b\xdf\x95\xae\xad\x23\x2c\xf9\xbc\x10\x1c\xec\xad\x5b\x51\x10\x03\x7b\x2b\x02\x1d\xd8\xa6\xf5\x6d\xa2\x61\xa0\xd3\x42\xbf\x2d\x54\x81\x30\x79\xd9\x2d\xd9\x2e\xe0\x42\x24\x4e\x7f\x10\xd3\xb6\x9e\x13\x4f\xa0\x19\xdd\x75\x6c\x06\x5d\x88\x2d\xef\xb2\x6e\x96\xb6\x00\x3f\x87\x39\x73\x30\xfe\x85\xc2\x2d\x10\xcd\xc1\xa9\x7a\x57\x57\x37\x35\x8e\xb2\xf0\xed\x7f\x6d\x0a\x21\x83\x42\x1e\xc1\xd8\xa1\x86\xed\x9c\x4d\x45\xaf\xf0\x7e\x47\x06\xeb\xc0\xf1\xdb\x84\xf2\x94\x64\x61\x1d\xda\x23\xfd\x9b\xc8\x62\x61\xfe\x16\x99\x62\x79\xf9\x6d\x1b\xec\xf0\xe8\xe4\xe8\xf2\xe8\xff\x63\xbb\x9c\x53\xff\xef\xb6\x61\xd8\xc7\x5c\x4e\xfa\x3e\xe6\x4f\xe7\xc7\x97\x47\x07\xa7\xef\x0f\x8e\xce\xdf\xaf\x7a\x9b\x41\xe8\x28\x6d\xe4\x48\x8e\xb4\xcb\x11\x54\x64\x29\x8c\x0d\x2d\xaa\x86\x32\x45\x59\x27\x20\x3e\xc0\x26\x84\xa1\xa7\x0e\x94\x38\x3e\x1c\x6e\x08\x3d\xc5\xa2\x2a\x31\x38\xa6\xc4\xcc\x54\x3b\xee\x3a\x03\x12\xa6\x0f\x0e\x61\xe7\x6d\x0a\x49\xe9\x8f\x00\x4f\x3d\xd2\x09\xb3\x1f\xcf\x2e\x22\xf1\x2d\x2c\x4d\x8c\xfc\x28\xc9\xb3\x6f\x48\x63\x86\xa6\x29\x1b\xac\x7c\xbc\x28\x6f\xc5\xa2\x51\xb2\xc5\x74\x47\x87\x0a\x43\x85\xe8\xbc\x81\x11\xb1\x4f\xd5\x37\x1f\x4e\x7e\xd0\xd4\xbc\xc2\x17\xd0\x6f\x63\x67\x89\x9d\x08\x14\x1c\x72\xd9\xa2\x99\xeb\x77\xb4\x10\x2b\x99\xd6\x6b\x83\xcb\x7e\x3b\xe7\x3c\x0f\xa7\x61\x80\x84\x20\x4b\x2d\x04\x43\xc8\x91\x79\x76\x3f\x96\x14\xbf\xc2\xef\xce\xcb\xdc\x54\x30\xa8\xea\x3c\x57\x46\x81\x71\xcd\x17\xa5\xb4\x12\xde\x40\x44\x15\x47\xa6\x19\x9d\xf4\x4f\x45\xc5\x96\x96\x5e\x50\x54\x0e\x41\x60\x0a\xf7\xb4\x8b\x0a\x9e\x4d\x47\x20\x83\x56\xfc\xad\x11\x34\x3b\x20\x6b\xe7\xb4\xda\x0d\xa6\x4e\x4b\xb3\x2f\xb5\x79\xa1\x60\xd2\xba\xcd\x18\x20\xbc\xb2\x19\x35\xf7\x66\xf5\x92\x13\x55\x9d\x4d\xc2\x64\x2d\x88\x1d\xe2\x81\x04\xcc\xeb\xa1\x56\x25\xb4\x52\x18\xf8\xaa\x52\xaf\xa3\x21\x4e\x8e\x90\x98\xa6\x85\x72\x32\x8c\x0c\xac\x80\xa3\x27\x86\x42\xd1\x88\x23\x2e\xbc\x71\x1f\xdb\x63\xde\x40\xd6\x57\x6c\x74\x72\x97\x9a\x53\x05\x56\x01\x68\x2e\x22\x13\x62\x52\xf7\xd5\xce\x35\x89\xad\x7d\x8f\xa1\x9e\xf7\xa6\x9e\x9a\xa4\x9e\x2b\x33\x1b\xe3\x9a\x4a\x2e\xc5\x21\x66\x0d\xb4\x78\x73\xdf\xee\x75\x22\xb6\xc5\xae\xfc\x4b\xa2\x03\x52\x0a\x2a\x80\xea\x9c\x5d\x66\x24\x08\x58\xaa\x29\x61\xde\x8d\x83\xd2\x66\xa1\x66\x31\xd3\x08\xa5\x0b\xb7\x4b\x30\x18\x0c\xd3\x49\x3d\xc9\x4a\x89\x49\xd2\x0b\x72\x75\x59\x3d\x3d\xf4\x40\x23\x45\xd7\x42\xae\x9b\x3e\x60\x1c\xfa\x28\xdc\x15\x89\x22\x80\xa5\xac\x6e\x40\x2d\xc1\x76\xdf\x37\x5c\x46\xa8\xd4\xf7\x6b\x5d\x54\x31\x6e\xcb\x04\x34\x81\xf6\xdf\x9c\x98\xa0\xd6\x60\x19\x01\x1b\x3e\x2d\x78\x36\xe6\xbb\x33\xd5\x89\x29\x90\x75\x62\xb2\xa8\xb0\xc7\x3b\x96\x1b\xcc\xa4\x98\xb4\xab\x16\xa5\x4c\x80\x18\x1e\xff\xed\xe8\xfc\xe7\x91\x4b\xa3\x23\x1a\x98\x17\xd5\xb9\x9a\x0e\x53\xf2\xb4\x6f\x49\xf1\xeb\x68\x5c\x96\xa0\x56\xa9\x87\x98\x12\x08\xd5\x21\x77\xde\xc7\x5e\xba\xa2\xc0\xa0\xd0\x4e\x4a\xa7\x45\x95\x1f\x57\xb9\xfc\xe8\x76\x60\x5d\xe2\x70\xe3\xf8\xc2\xaf\x14\x73\xb1\x30\x0b\xff\x3e\x18\xe1\x2b\xc4\xeb\x3d\x88\xcc\x34\x5b\x1c\xc4\xab\x02\x05\x2a\xef\xcb\x5c\x88\x67\x7e\x0d\xc6\xbd\x02\x17\x27\x76\xed\x49\xaa\xc0\xeb\x91\xf1\xce\x50\xfc\xd9\xe7\x96\x1b\x01\x2c\x9b\x75\xf3\xd2\x9b\x93\xce\xb3\xc6\x21\x9e\xfb\xa9\x01\x4e\x90\xee\x89\xd3\xf1\xaf\xe0\x33\xa4\xb7\xf2\x5e\xc5\x39\xd9\x7d\x7c\x53\xdd\x76\x3a\x45\xe0\x12\xa2\xaf\xf2\xe2\xee\xb5\x11\x8b\x3c\x45\xf5\x80\xce\x7b\x6e\xb2\xc1\x20\x1d\x24\x15\xb6\x81\xc4\xc1\x79\x11\xf4\xc6\x10\x23\x17\x8f\x5b\xb4\x90\x38\x8c\x06\x07\x6f\x26\xa3\x00\x76\x11\x44\x29\xae\x52\x2f\x65\xc7\xff\x4d\x20\x4e\x13\x03\x74\x12\x06\x23\xcf\x73\xd2\x88\x5b\x27\xe7\xef\x8b\xaf\xbe\xd9\xfd\x3a\xea\x4d\xe3\x8c\x7d\xee\xcd\xe4\x76\x76\x89\x9e\x06\xf8\x8d\x88\x69\x6d\xec\x21\x61\x7b\x12\x05\x6e\xd2\xda\x79\x3b\x5f\xfd\x85\x52\xed\x55\xca\x42\xfb\x5a\xec\x12\xf9\x3a\xb0\x43\x0c\x8e\xdb\x47\x3a\x09\x80\x2a\xdc\xdb\x68\x9a\xcf\x1c\xa3\xe9\xf6\x57\xe3\x16\x98\x13\xec\x3e\xfc\x37\xc5\x33\xa6\xe9\x14\x36\x10\xd2\x14\x8f\x66\xb4\x86\xcf\xd9\xd0\xa1\x0e\x26\x45\xb1\xcc\x14\xef\x35\x52\xd3\xaf\xa8\xf3\x1f\xd8\xf3\x5a\xbc\x9a\x17\x93\xb6\x86\x68\xb2\x86\x7d\xfa\x5a\x2b\x6a\x75\x2b\x97\x6a\x8d\xa2\xbe\x80\xf6\xb8\xc8\x87\xe2\xb7\xe9\xea\x67\x04\xcf\x3f\xe8\xb1\x0d\x56\x47\x6f\x79\x8d\x81\x7a\x5e\xab\x96\x77\x76\x76\xd0\xb7\xff\xae\xf8\x08\x86\x63\x57\x3b\xd9\xd1\x1a\x2d\x8d\x3e\x51\x8c\x8d\x7c\xfa\x52\x89\x15\x54\x9c\x52\xa5\x21\xcf\xb5\xa0\x3b\x64\xb0\xf9\xda\xdf\x94\xd8\xa5\x4f\xe0\x8c\xeb\x89\x4d\xeb\xb4\xa6\x61\xd9\xfb\xba\x93\xce\xae\x9a\x04\x04\x30\xb1\x04\xe5\x38\x96\x80\x25\xd8\x58\x01\x2e\x02\x39\x0c\x02\x37\xf8\xf4\x3e\x4e\xfc\x8c\x3f\x8c\x2f\x8b\x4a\x7a\xc1\x1b\x8d\x2a\x9c\x36\x8f\x2e\xb3\x5b\x4c\x90\x9b\xa1\x2e\x46\x63\x87\x85\xa1\x6e\x9b\xee\x68\xad\x07\xee\x67\x19\x37\xbc\xe6\x8d\x06\x00\xee\xfd\xad\xe4\xc8\xc7\xda\x50\xce\x30\x16\x94\x02\xdc\x60\x89\x7a\x0e\xf8\x66\xe7\xa7\xff\xe2\xc7\xfc\x1f\x3a\xcc\x94\x93\xdb\x90\xbe\xd9\x4d\x86\xee\x9c\x39\x84\x55\xb0\x5d\xf1\x24\x04\xac\x25\x65\xb2\xc0\xe4\x1c\x7f\x77\x7c\x74\x28\x40\x77\xa9\xec\x86\xbc\x6e\x14\x73\xce\x8b\xd7\x8b\x0e\x8f\xfa\x3c\x1e\x18\xb6\x6c\xe6\xc0\xdf\x68\x44\x10\x1f\x07\xb4\x87\x81\x1b\x17\xfd\xb9\x4b\xee\x39\x7c\x88\xf0\xdf\xf4\x34\x3a\x46\x73\x07\x41\x36\x28\xf6\xc0\xea\x0c\xf1\x63\x81\x31\x1e\xe0\x71\x68\x8c\xf1\xa4\x4e\xf5\x72\x23\x79\x09\x65\x09\x6e\xe0\x64\x31\x97\x78\xf4\x3d\xc7\x03\x5b\x8c\x13\xa2\x77\x85\xa2\x1f\x52\xb9\x8d\x8f\xb3\x8c\xbc\x99\x75\x7a\x40\x23\xd0\x92\xb5\x95\xd7\x24\x32\xbb\xcc\x1f\x32\x62\x3d\x62\x37\x32\x06\xc2\xb8\x91\x11\xb4\xa7\x86\xf4\xa1\x16\x2c\xd8\x76\x5c\xe1\xd0\x6b\xdb\xa7\xd5\x02\xcd\x37\xbb\x7c\x92\x32\xca\x39\xb6\x6c\x73\x8b\x4e\xa5\xd9\x93\x6a\x18\x53\x28\x3a\x79\xc2\xde\x12\xf4\x2e\xfe\x9d\x15\x37\x33\x50\xed\x3b\x4e\x64\x09\xfe\x73\xf0\x46\xd9\x6a\xf8\xd3\x22\xa1\x9f\x87\xfa\xad\x0c\x25\x02\xa1\x5c\x9a\x26\x02\x88\x6d\xf8\xa0\xed\xcc\x43\x9f\x60\x68\xa9\x33\xb4\xd3\x59\xd7\xb5\x71\x34\x03\x0b\x87\x7a\xc6\x48\x17\x02\xd2\xcb\x4b\x27\xea\xce\x77\xf3\x12\x7c\x4f\xe4\x9f\x00\x9d\xd5\x7a\x67\x4c\x66\xa0\xff\xf9\x48\x42\x34\x6d\x8d\x69\x43\xf4\xbe\x40\x1b\x39\x97\xad\x5f\xae\x00\x9d\x67\x3c\xd4\x4b\xef\xd2\x4c\x96\x38\x0d\x07\xff\x45\x09\x68\xf2\x14\x9f\x50\xa5\x1b\xc9\xa0\xac\xb2\xa6\x1d\x1b\x51\xcf\xcf\x6e\x65\x96\x53\xd9\x03\xea\x95\xef\x60\xe4\x39\x35\xe8\x1c\x31\x3e\xa6\x75\xa5\x0f\x98\x57\x72\x07\x61\xd0\xa3\x11\xf1\x62\x9e\x20\x15\xa5\xa1\xc1\x1e\x5b\x94\xdd\xe7\xe6\xa2\x1e\x57\x5d\xc2\x27\x00\xfc\xb9\xd1\x07\xcd\x8f\xe8\x30\xad\x12\x1f\xdc\xf2\xf0\xcf\xbe\xc2\x11\xb1\xcd\xbf\xbb\x2d\x0f\x96\x3b\xa4\xfe\x53\x6b\x5e\x89\xf3\x64\xd6\x82\xc7\x15\xb2\xd7\x26\x10\x50\x02\xb1\xc6\xe5\xc7\xb3\x0b\x3f\xa1\x00\xde\x13\xbc\x1b\x1e\x41\xa9\x86\xa2\xc0\xe0\x3c\x49\xa0\x78\xe0\xc6\x18\x77\x64\x38\x19\x4e\x1a\xd6\xbc\x7c\xe9\x12\xc4\x4c\x34\x1e\x9e\xbe\xcb\x3e\x9e\xbd\x7c\x09\xb3\xbc\x44\xc3\xea\x1c\x2f\x48\x72\x03\xb8\x48\x67\x33\xdc\x23\xec\x3f\xc7\xd3\xdc\x35\xd0\x7b\x93\xd7\xbe\x00\x08\xd0\x6c\x00\x7f\x01\x5d\x5a\xec\x57\xc7\x1a\xbf\x21\x8c\xc4\x69\xd8\x26\x99\xec\x1d\x7f\xf0\x5b\x3e\x3f\x51\xfa\x84\x55\xd5\x04\xfd\x2c\xb9\x7c\x58\x95\xbb\x80\xd5\x4f\xac\x6a\x6d\x76\x01\xff\x65\x82\xc7\x0a\x90\xbc\x9b\x9a\xf4\x22\x86\x76\x18\xef\x95\x5c\x47\x24\x26\x8b\xf6\x0e\x60\xc8\x0c\x0f\x57\xb9\x10\x05\x20\xa0\xe7\x9c\x0f\x8d\x27\x65\xe4\xf6\xa6\xee\xec\x7c\x90\x94\xb4\xe7\xb4\x32\xc6\xca\x33\x8c\x28\xdc\x7b\x42\xa5\xf4\xf0\xad\x7e\x30\x91\x0d\xea\x60\x94\x7c\xf6\xaa\x59\x99\x7b\x99\x5d\x95\xea\xed\xf0\x9f\x0d\x67\x77\x45\x86\x0e\xb4\x62\xaf\x91\xa3\x1b\x9b\xe5\x8d\xcc\x99\x3a\xa0\xa5\x07\xd0\xab\xf4\x2a\x53\xc4\xb6\xef\xc6\x26\x64\x4d\xab\x1a\xd6\x07\xc6\xe3\x5e\x76\x3d\xbf\x36\xe2\x05\x61\x7d\x03\xc8\x1d\x85\x1a\x2a\xc5\x67\x65\x42\xf9\xe7\x62\x57\x87\x66\xb4\x40\x1c\x82\x0f\x5a\xa6\x15\x79\x7c\xbe\xfb\x8b\x5e\xb6\xa2\x04\x42\x03\x96\xf0\x5b\xf3\x06\xa1\x5b\x2c\x98\x91\xed\x41\x08\x23\xab\x27\xb4\x4e\xc8\xc6\xa0\xfe\x31\x28\xb4\x8a\x30\xb6\x24\xa6\x3f\xb4\xac\x4a\xfe\x15\x93\x72\xfa\xf4\xb0\x68\xd1\x08\x31\xe1\xd1\x46\xa2\x8f\xe2\xbb\xda\x6b\x85\xb5\xd3\x02\x1a\xee\x38\x1b\x22\x1b\x6a\x04\xd1\x28\x36\x85\xf6\x86\xc2\x5e\xd5\x64\x95\x8d\x7b\x71\x8c\x27\x05\x10\xcb\xed\xbe\xfc\xaa\xcf\x7e\x1c\x63\x59\x6f\xd9\x8a\xad\x8f\x31\x35\xc8\xfc\x33\x10\xf6\xa6\x74\xe4\x8a\xb4\xe1\x98\x13\x23\x40\xb2\xee\x83\xae\xe8\x4a\x39\x60\x3e\xa7\xcb\xd9\x3d\x9e\xa1\xd1\x73\x33\xc3\xd3\x66\x3e\xf9\x1b\x80\x9b\x2a\xdb\xef\x2f\xdf\x9d\x0c\x82\x90\xd1\x8f\x3e\x82\x80\x31\x2b\x6b\x10\xab\x2c\x34\xf7\x85\xea\x6d\x1a\x63\x5e\x1a\xef\x44\x9d\x25\xa5\xb1\x47\x0b\x23\x8c\x32\x6f\x24\x11\xa0\x49\xf9\xd9\xb8\x51\xfa\xb7\xb2\x9e\x56\x93\x42\x6c\x92\xbb\x8d\x31\xec\x9f\x98\x34\xa9\x2c\xb3\x06\x04\x80\x28\xa6\xb0\xea\x66\x65\x44\x57\x77\x59\xc9\xfd\xa6\x12\x4e\x03\xb7\xb5\x6a\x94\x1b\x5c\x27\xe1\x3e\xde\xd8\x2d\xb2\x29\xda\xb4\xc7\xb1\x88\x3c\x59\xdc\x60\xd1\x3b\x73\xe8\x08\x84\x7e\x97\xb5\xb7\x40\x5a\x43\x55\x26\x09\x9f\x23\x60\xc1\x1f\x4a\x3c\x6a\x16\xaa\x70\xf4\x08\x3e\x87\x69\x96\xe0\x43\x3e\xe9\x66\xec\x6d\x51\x6c\xfa\x71\xff\x63\x81\xfe\x13\x1e\x86\x9e\x95\x75\x77\x82\xb1\xa0\x71\x7c\xc1\xd9\x1d\x21\x11\xd4\x50\xdb\xa5\x12\xb3\xce\x83\x2f\xa6\x3b\xd9\xd7\x3b\xd9\x80\x5b\x97\x45\xde\xcd\xb0\x3a\xd0\x3f\x4e\xff\x84\xe8\x8f\xf8\x17\x4a\xda\x3d\x1e\x19\x7f\xea\x03\x78\x78\xd8\xf2\x02\x2d\x12\x27\xcf\x2f\xe0\xaa\x59\x72\x16\x5c\x7d\x25\x9d\x66\x90\xf6\x26\x2d\x8a\xe7\x1a\xb6\xcc\x31\x90\xb4\x03\x06\xa2\x0f\x91\x34\x87\x71\x61\xf4\xe8\xcc\x35\xf7\x98\x02\x23\xea\xf3\xdc\x6f\x64\x90\x46\x87\x91\xb0\x21\x99\x71\x5e\x3c\x71\xe1\xd9\x74\xd8\x46\xb9\x0a\xa3\xc1\x49\x57\xeb\xb0\x18\x01\x7a\xa7\x21\xba\x22\xc2\xe0\x10\xa4\xf7\x36\xbf\xdb\xd6\xf4\xe9\x93\xac\xc0\xff\xa6\x93\x12\xf4\xf3\xc4\xb2\x6e\x6f\x69\x3b\xae\x3f\x2b\x41\xe7\x57\x05\x87\x25\xe7\x47\xfb\x87\x17\xfe\x69\x09\x37\x04\xa7\x22\x08\xb9\x45\xf7\xc0\x34\xd3\x11\xfc\x4e\x74\x1d\x9c\xd4\xdc\x2c\xb2\x16\x86\x15\x58\x45\x09\x2c\xba\x95\xf7\x7b\x24\x4e\x1b\x50\x79\x6b\x87\x07\xf8\xbc\xfd\xb0\x7f\x7e\x78\xbe\x7f\x7c\x62\x90\x42\x04\xbc\x46\x53\x65\xc9\x99\xa1\xed\xab\xbf\xab\xe1\xf5\xf3\xed\x04\x83\x02\x2c\x77\xb6\x4a\x1a\xa8\xe4\x65\x5f\xe1\x17\x08\x9d\x45\x37\xbb\x87\xad\x65\xf1\x35\xc6\x89\x04\x91\x12\x27\xe8\x01\x80\x96\xec\x89\xd7\xfe\xb8\x6e\x3b\x50\xc4\x40\x0e\x70\x92\x5c\xe8\x9e\x71\xbb\x65\x95\x1e\x67\x72\xea\x36\xa1\xb1\x3e\x8f\x41\x6a\x6d\xcc\xf9\x3c\x0d\xd9\xdf\x1c\x36\x21\x87\x4c\xe0\xb4\xb5\xb7\x5f\x74\x3b\x8b\x61\x6a\xeb\xcc\x79\xec\x6a\xd2\x8d\xd9\xcf\x55\x69\x34\x86\x17\x61\xa7\x5c\x99\x02\x2d\xfa\x65\x32\xdd\xb9\x29\xaf\xb3\x99\x56\x23\x14\xaa\x97\xbb\xe2\xf7\xea\x95\xe4\x0a\x8d\x1b\x2d\x4f\x2b\x60\x6e\x74\x56\x71\xe8\xb5\x3e\x6a\xf1\xc8\x67\x67\x97\x34\xb4\x67\x3c\x97\x4b\xe7\x53\x7d\x3c\xed\x4e\xaa\x7d\xf8\xea\xb6\x20\x1e\x63\xaf\x79\xa6\x33\x54\x3e\xd5\xd3\x61\xe2\x78\xa1\xee\xcd\x9b\x36\xa7\x35\xd6\xbd\xf5\x35\x97\x57\x91\x75\xd3\xda\x66\xc7\xcf\x35\x70\x6d\x78\xe5\x68\xed\x57\xb0\xfb\x9d\x4a\x17\x70\xbe\x07\x4f\xf4\x06\x8c\x8b\x49\x09\xb0\xa2\xd9\xf3\x99\x05\x4f\xd7\x48\x63\x57\xd3\xee\xf1\x86\x26\x58\xcb\x62\x94\x1c\xf8\x09\x56\x65\xf1\x3a\xb6\x95\x9c\x44\xc1\x91\xcc\x7e\x8e\xb5\xf0\x28\xb6\x5c\x44\x9c\x55\xd2\x2f\x84\x7e\x2f\x97\x78\xe1\x81\xf2\x73\x58\x12\x8e\xf4\x70\xf5\xe3\x94\xb5\x23\x91\x29\x72\x2d\x5a\x5e\x59\x26\x66\xed\x61\x00\x74\x90\x89\x6b\x50\x32\xf8\x84\xa1\xab\xc5\xf1\xe1\x40\x99\x29\x57\x04\xc7\x88\x23\x81\xfa\x12\x96\x32\x6f\x50\x4e\x54\x1c\xe1\xa0\x4b\xfd\x13\x0f\x4d\xb9\x5c\x1c\x8c\xdf\xa2\x81\xa5\x7c\x11\x74\x6f\xe9\x98\x18\x5e\x79\x5c\xd1\x41\xb2\x01\xa4\xc9\x35\x2e\xeb\xc9\xed\x49\x81\x55\x26\x26\x3b\xa2\xc3\xff\x16\x9e\x25\x97\x39\x18\x70\x9c\xd6\x84\x7e\x0d\xf2\x43\x93\x63\x2c\x88\x9b\x92\x29\x4b\x72\x41\x0b\xa3\x8a\x6e\xba\xbf\x80\x04\xe3\x9a\x56\xbb\xaf\xab\x7b\x3e\x7f\x52\xda\x0e\xd1\x82\xb9\xc5\xa6\xb6\x61\x20\x45\x9b\x2a\xb6\xe4\xe0\xc8\xce\x0c\xd4\x3c\xd7\x4c\xe3\x0c\x0c\x26\xb7\xb1\x3e\xde\xde\x7d\x40\xc3\x27\x97\x74\x41\xc3\x22\x1f\x5c\x8e\xe0\x01\xfa\xda\x45\x38\xce\x83\x8c\x59\x4f\x1f\x57\xfc\xed\x27\xcc\x20\x62\xd0\xec\xa5\x2e\x1d\x41\x50\xb7\xdd\x05\x78\x6a\xb6\xf3\x57\xf8\xf3\x0a\x87\xc3\xc3\xf3\xe7\x2e\xa4\x2c\x88\x39\x27\xf5\x0d\x9d\x0b\x58\x40\x57\xc5\x75\x12\x48\x38\xe0\xf4\x1f\xb2\xad\xe9\x4e\x0c\x08\xb7\x6c\x2b\x43\x75\xd9\xe2\xc2\x7b\xd7\x5f\x34\xd1\xc1\x2e\x97\x25\xa7\x6e\x41\xd6\xc6\x75\xd7\xd5\x73\xbd\x61\x15\xf5\xf9\x97\x63\xb8\xc5\x68\x37\xe4\xa1\x1e\x92\xf2\xc3\x25\xb8\xcb\xb6\x09\x9d\x8d\x9d\x44\xf7\x7c\x2f\x21\x92\xe9\x8c\x73\xe1\xef\x0c\x94\xb5\x41\x7a\xd3\x16\xf9\x20\x49\x0b\x55\x83\xd1\x91\x31\x9e\x2c\xe1\x90\x63\x10\x49\x35\x10\xae\xc3\x85\x2e\x03\xda\x0b\x2f\x58\x1d\xed\x45\x5d\x5d\x97\x5d\xd1\x44\xd7\x58\xac\xc2\xcf\xf1\xa7\x1c\x5c\xcd\x7b\xf0\xb6\xc0\xcf\x04\x99\x01\xb7\x14\x8f\x35\x40\xfd\xcd\xc0\x9d\x82\x5f\x3b\x0f\x82\xce\x3d\x6f\x6e\xf0\x36\xcb\x60\x56\x83\xce\x1b\x58\x27\x8c\x8b\x5d\xd0\xd9\xc4\x9d\xce\x57\x1f\xb0\x6e\x9c\x0b\x43\xdc\xc6\x7f\x0b\xda\x88\x76\xbe\x29\xdb\xde\xb0\x1e\x84\x36\x60\xc3\x8d\x00\x0c\x15\xdd\xa0\x01\x20\x0b\xcc\xa3\x28\x03\x30\x97\x9d\x5c\xd1\x13\x80\xd6\x61\x5d\x0d\x3a\xe3\xf2\x82\xc3\x5f\x4c\x68\x37\xf9\x13\xac\x41\xd2\x8d\x4e\xa4\x5d\x5b\x20\xe9\xe1\x6e\xe4\xe5\xbf\x95\xdd\x4a\x85\x3e\x78\x2b\x26\xa3\x45\x97\xb8\x80\x5e\x64\x7a\x33\x52\x8d\x63\xa0\xc8\xcc\xee\x70\xb0\xf3\x08\x06\x6f\x35\x39\x5a\xc1\xa6\xd1\x6f\xd9\x44\x29\xad\xfd\x81\xef\x17\x12\xcb\xb1\xc8\x4b\xa6\x41\x2f\xb0\x11\x08\x08\x40\x17\x78\xf2\x00\x08\x34\x19\x56\xa5\x80\x68\x83\x31\x22\x06\xf9\xf3\xc0\x49\x06\x34\xc9\x4f\xae\x04\x56\x7b\xbd\xd0\xda\x10\x7c\x89\x16\xa5\x42\xef\x2f\xe8\x59\xcc\xab\x9f\xd8\x77\xff\xd3\x9f\x74\x01\xa9\x00\x47\xa8\xa3\x5b\x4e\x5f\xef\xb8\x6d\x96\x04\x65\xe4\x54\xa2\x41\x8a\xd6\xfa\x57\xb4\x3f\xc5\x86\xfd\x4f\x74\xf9\xed\x0a\xc0\xb7\x2c\xfa\x6c\xb7\xb8\x06\x13\x93\x78\x19\xec\x55\x3f\x9f\xcb\xba\x5d\x22\x85\x86\x91\x53\xda\x51\xd0\xc3\x52\xc1\x88\x59\x64\x61\x20\x2d\x00\xed\x69\x3b\xa7\x8a\x26\xfc\x7d\x15\xe9\x8e\x48\xeb\x1c\x17\x35\xf1\x55\x3e\x0c\x9b\x40\x82\x68\x82\x1e\x9a\xe8\x20\x48\x75\x97\x17\x00\x4f\xb7\x5e\xe9\xbf\x9a\x0a\x2f\x76\xaf\x39\x63\xcd\x2a\x75\xc5\x8b\xd3\xee\x8f\x23\x26\xff\xd6\x38\x32\x6a\x5e\x5b\x44\xc5\xca\x57\xd7\x9b\x89\xeb\xc6\x6a\x0c\x7a\xb4\xf6\x6f\x45\x52\x20\x08\xa1\x7e\x17\x7b\xd3\x02\xb5\xfb\x4f\xe8\xae\xd0\x9b\xc0\x7b\x8b\x78\xad\x4c\xd7\x9e\x01\x1c\x5d\x76\x86\x6b\x87\x3e\x3c\x98\x70\xbb\x27\xb4\x04\xc1\x49\xd4\x67\xe8\x6a\xca\x4c\x99\x61\x7f\xf8\x83\x9d\x02\x0c\xf0\xe5\x35\xeb\xde\x90\x11\xc0\xf4\xdd\xaa\x76\x47\xd7\x73\x17\x6b\x33\xbc\xd9\x81\x8a\x17\x2f\xdc\x34\x4e\x9c\x50\xb3\x2e\x87\x30\xd7\xef\x2e\x39\x63\x60\x50\x35\xb7\x2d\xd1\xfb\xea\xc6\x75\x7e\x2f\x3a\x08\xd5\x40\xef\x46\x58\x76\xae\xba\x58\xfb\x6b\x89\x75\x0d\xe9\x2e\xde\x9e\x85\xa5\x9d\xc3\x0b\xf2\x04\x7e\x05\xe9\x01\x5a\x4d\x40\xe5\x4a\x2c\x33\x73\xe5\x11\x2b\xb7\x3d\xd7\xbe\xd9\xbd\x14\xcb\x57\x80\xc1\x7a\xda\x20\xb1\x1a\xc3\xbb\x10\xaa\x83\xe7\xa0\x8d\xea\xb2\xc1\x4d\x27\xd7\x4a\xe3\xd1\x9f\xbb\xf6\xea\xe8\x34\x2b\xf9\x96\xc3\x4a\xe9\x29\x5f\x3e\x1c\x50\x3a\x8d\x15\xde\x5e\x64\x96\xc3\xd3\xa3\xd7\xbb\xaf\xb6\x29\xdb\xc6\x39\x37\xbc\x8c\x86\xb3\x9e\xc3\x2c\x6e\x1f\x78\x49\xea\xb5\x8b\x77\x8e\x5e\xf4\xaa\x6b\x5f\xbf\xea\xf2\xd7\x91\x85\x12\xbd\xda\x86\xdf\xf0\x3f\xac\x94\xf0\xaa\x7a\x8d\xc0\x68\x1e\xfc\x20\x65\x63\x52\x31\xec\x4e\xa4\x36\x2d\xf7\x4f\xb8\x0b\x5e\xc5\xac\x51\x13\xc7\x53\xda\x1b\x6c\x96\x0b\x85\xe6\x10\xb6\x17\xc4\xa7\x96\xe7\x94\x08\x31\x94\xb6\x32\xf3\x0c\xaf\xeb\xd1\xba\xf5\x3d\x3d\xd8\x99\xf1\x60\x74\x57\xa8\x62\x0c\x61\x53\x92\x78\x26\xe1\xe9\x5b\xc1\xff\x3a\x41\x30\x19\x0d\x8a\x63\x7f\x3c\xbb\xd0\xda\x4f\xdf\x8e\xa2\xdb\x90\x82\xcb\x4b\xe0\x0f\xdd\xb4\x5d\x62\x3a\x08\x7d\x97\x8a\x4d\xe0\xa4\x06\x27\x1d\xad\x3e\x4e\x6d\x50\xa5\xa1\xc6\x41\xab\xdd\xd5\x94\xf4\x07\x5e\x7d\x38\xd6\x45\x9b\xc6\x34\xa4\x5b\xf6\xb6\x2c\x69\xf0\x1d\xbe\x9b\x8d\xd9\x64\x88\xd3\xc1\xf2\xcd\x1b\x7c\x11\x40\x91\x79\x1a\x54\xf5\xca\xc9\x2d\xa0\x49\xc6\x80\x69\x6a\x55\x31\xb0\x05\xc0\xeb\x35\xf8\xa1\x1e\x74\xbc\xd2\xaf\xf2\xee\x48\xb1\xab\xa2\x87\x5f\x75\x8a\x1c\xa0\xd3\x12\xdd\x60\xaa\x67\x2a\xf4\xee\xee\xed\x15\x04\xe8\x4d\xe2\xfd\x8b\xea\x02\x55\x2b\xb9\xc0\xb1\xb9\x0f\xf8\x16\x0f\x36\xc0\x7c\x83\x0f\x0c\x6e\xdc\x33\x2b\xa9\xce\xc0\x59\x23\x70\x65\xd2\x64\x2a\x19\xfa\x38\xa5\x6a\x31\xbf\x4e\xbc\xa3\xc9\xc1\x17\x60\xe4\x38\xe8\x19\xd7\x65\x6e\xaa\xa7\x7b\x53\x48\x28\x3e\x9c\x51\x94\xf4\xe1\xd8\x4e\xb7\xe4\xee\x94\x6d\x5b\xa5\xc3\xca\xc6\x78\x08\x2f\x84\xfb\x3c\x18\x72\x31\x0f\x49\xd0\x4a\xfa\x24\xb8\x64\x8f\xfc\x5f\xb8\xb8\xcd\x99\x61\x5a\x4c\x3f\x81\xad\x43\x30\x8e\xbe\x18\x82\xcb\x9b\x70\x20\x66\x13\x27\xdc\xbd\x39\x73\xd2\xcf\x0f\x70\xed\x61\x87\x59\xa8\x7e\x99\xd1\xa4\x24\x19\xd2\x69\x86\x30\x32\xb4\xd9\x80\xa0\xf7\x0a\xa6\x5c\xbb\x62\x83\x95\xae\xb0\xc8\x80\x5f\xcb\xf5\x4a\xf8\x2e\x5b\xc8\xb0\x32\x2f\x59\x49\x30\x70\x67\x2f\xfb\xa3\xbf\x6e\x60\xd3\xa2\x6b\x33\x36\xfa\xad\x5e\x35\xe8\x73\x5b\xe6\xc0\x2f\x6e\x25\x1d\xcd\xe9\x0c\x85\x3e\xa6\xf3\xfa\x57\xd2\x3c\xc9\xd3\x19\x9b\x35\xb9\x1a\xe3\x4f\x2c\xe6\x94\x29\x03\x2c\xb1\x9e\x05\x8f\xa8\x20\x70\xa2\xdb\xb4\x32\xd7\xbf\x78\x1a\x3d\x6a\x84\xe8\x99\xd7\x0b\xfe\x35\xe5\xb4\xd7\x66\x7a\x42\xae\x91\x6e\xee\x25\x7b\xec\xf6\xb4\x99\x1e\x3c\x9b\x2d\xaa\x85\xf4\x6c\x81\xa9\x47\xf1\x05\x0e\x93\x3f\xec\x11\xc3\x0a\x52\x8b\x3e\x09\x85\xfb\xe9\x46\xd8\x25\xf1\x08\xfb\xd3\x8d\x30\x84\xc5\x6e\x7e\x76\x7d\x96\x29\xd8\xa9\x7f\x84\xce\xa7\x11\xd7\x9e\xa4\xea\xf9\xbe\x14\xe2\x11\x61\xbf\x8d\xae\x27\x52\xf1\x4a\x5f\x94\x1f\x56\x8b\xa6\xfb\x2b\xc6\x83\x53\xf3\x8b\x45\xa5\xbf\x62\x4c\xfa\x99\x5f\x43\xef\x00\x2d\x5c\x5b\x5f\xe0\x02\xaa\x58\x71\x33\x67\xa8\xe4\x9b\x98\x7a\xe1\x55\x42\x78\x4b\xf4\xf9\xbf\xb2\xf2\x60\x4f\x6a\x98\xab\x9b\xb2\x3f\x2d\xdc\x92\xb4\xdd\xf5\x54\x7d\x70\xeb\x81\x75\xd5\x44\xff\xfb\xdf\xff\xc3\x3b\x49\x8f\x75\xbb\xb0\xbf\xb7\x59\x11\x06\x07\x53\x8f\x6c\x2f\x9f\x50\xb4\xb9\xfc\xa3\x7e\xcf\x16\x79\x45\x1d\x64\xa8\xac\x0a\xe4\xd8\xd1\x4b\x02\xb8\xe0\xf1\x73\x42\x47\x93\x43\xb5\x19\x84\x6b\xe3\x27\xdf\x16\x8d\xc8\x4a\x4c\x74\xde\x6b\xeb\x92\xa3\xfd\x53\xbe\x6f\xf2\xfc\x79\x40\x4c\x7d\x64\x40\x5d\x61\xcd\x82\xcd\x1c\xd8\xd4\x3f\xbe\x94\x06\xda\xe4\x3f\x8d\xd0\x85\xcc\x9e\x11\xd6\xc1\xd0\x21\x04\x91\x77\x6c\x74\xf0\xc8\x15\x3c\x96\x0f\xe7\x27\xb6\x58\x66\x29\xc7\xaa\x9e\xdc\x42\xdc\x3a\xd1\xb7\xe3\x6a\xff\x88\x6b\xa9\x3e\xb4\xa5\x47\x44\x34\x19\x4b\x70\xdc\xeb\x65\x5a\xd6\x5c\xbe\xe1\xd0\x8f\xe3\x12\x4f\x75\xbb\x1a\xa2\x7e\xf0\x08\x80\xfb\xb3\xae\x6b\xd4\x08\x14\xee\xb7\x22\x5a\x2a\x35\xda\xde\xa6\xb4\xef\x92\x9e\x70\xef\x95\x29\xde\xef\x47\x37\x7a\x7b\xa9\x06\xc1\xd2\x21\x96\x5e\x34\x3f\x59\xf4\x5c\x56\xe3\x8b\x16\xfd\xb1\x41\xf2\xe8\x17\x62\xf8\x63\x1d\xba\x06\x0b\xa0\x5c\x10\x94\x58\xaf\xc7\xf4\xa7\x75\x35\x29\x6b\x25\xfd\x42\x2c\x79\xd7\x79\xc9\xb3\x27\x5e\xe6\xb9\x92\x18\x77\xb2\x46\xa0\xf2\x33\xa9\xe9\xa9\xef\x8a\x5b\xef\x21\x5c\x96\xf1\x1f\xbc\xef\x8b\xd4\x15\x3a\xdc\x1b\x30\x22\xb5\x5d\xe7\x01\xb3\x74\xbb\x49\xa9\x61\x92\xf7\x63\xa1\xf0\xf8\x8b\xa5\x3b\xed\xa5\x4b\xd6\x8a\xfc\x67\x27\x4c\xff\x59\xb1\x17\x5e\x2e\x6f\x43\xec\x61\x33\xac\x68\x8e\xc0\x47\x94\x2d\x4f\xf6\xbe\xbf\x64\x7f\xfa\xdf\x5f\xa2\x5d\xe5\x7d\x4d\x29\xf8\x12\x8f\x6b\xf2\xf2\xca\x04\xe2\x99\xfb\x9a\x0f\xd0\x85\x95\x17\x0d\xa2\x93\xf5\x63\x4e\x2c\x08\x08\x53\x0b\x35\x93\x78\xe8\x85\x79\x79\xa0\xaa\x17\xf3\xfa\x6e\x55\x2f\x63\x01\x7c\xb3\x2b\xd7\x09\xca\x03\x1d\x29\x0c\x86\xb6\xc7\xe5\xb6\xb0\xa8\xa4\x1b\xea\x3b\x89\x9c\xdf\x10\x7e\x55\x9e\xe7\x80\xf9\x63\x7c\x0e\x3a\x0e\xe9\xb3\x97\x3d\x64\x60\x08\x24\xc4\x71\x3a\x8d\xfb\xb8\x25\xc1\x60\x97\x53\xf4\x9a\x1f\xb6\xfa\x4f\x61\x54\x4d\xd2\x48\xf9\x8a\x0a\xf4\xc4\xd6\x5a\x48\x0f\xa1\xc8\xeb\x12\xe6\x0d\x52\xef\x3e\x10\x44\xc5\x65\x4d\xd6\x2a\x89\xfd\xa9\xcb\x85\xf5\x7c\x5f\xac\xa2\xf5\xdc\x15\x7d\x43\x83\xbe\x0f\x74\xdf\xd8\xda\x0a\xbe\x4f\xf1\xfe\xe8\xa7\xf7\xa7\x87\x47\xf6\x42\x85\x49\x09\x1a\x18\xc3\x35\x3b\xc6\xa9\x19\x2a\x71\x05\xa9\xbf\xf5\x20\xbe\x3d\x7d\x7f\x14\x80\xf4\xd3\xd8\x1b\xe6\x5c\xee\x9f\xbf\x3d\xba\x84\xb0\x62\xff\xd2\x4e\x73\xd7\x40\x21\xe8\xe9\x79\xbe\x5e\x41\x80\x57\x4b\x8c\xb1\x30\x5e\x3b\x9a\xe1\xb5\xb7\x7a\x4a\xda\x20\x2c\x21\xd8\xf0\x7a\x53\x4c\x6e\xdf\xbd\x52\x98\xbd\x61\xa2\xb9\xfc\x14\x4c\xb4\xb7\x97\x1c\x6a\x1b\xa6\xef\x9f\xbf\xeb\xbd\x94\xaf\xd3\x3d\x39\xf1\xe2\x87\xa3\x9f\x82\x79\xf6\x76\xc7\x93\x53\x5d\x61\x84\x47\xeb\xd5\x24\xef\x93\x70\xce\xce\x4f\x0f\xfa\xec\x02\xfb\x37\xe9\xb3\x8a\xda\x7a\x9c\xda\x00\x12\x70\xb2\xe0\xc8\xc3\x5a\xf3\x91\x31\xff\x9c\xcd\x45\xd0\x2b\xa0\x1f\x3c\xac\x56\xc4\xc7\x55\x99\xef\x5e\xfb\xf9\xa6\x67\xe6\x2b\x74\xfd\x57\xfa\xed\x4e\xa5\xba\xb7\xf8\xfd\x74\x06\xef\x55\xb2\xfb\xf0\x4d\x9c\xed\x10\xd8\xb9\x0e\x5e\xb4\x76\x00\xbe\x91\x7c\x1e\x1d\x29\xcd\x47\x3b\x7d\x04\xd6\xce\xc3\x5c\x00\x6a\x4c\x7f\xb9\x8f\x8e\xf7\x9d\xb3\x55\xee\x9c\xec\x5f\x1e\xbd\x3f\xf8\x39\xe0\x90\xd1\x12\x98\x02\x71\x1f\xec\xf3\x57\xb4\x3e\xed\xbd\xc2\x2f\x76\x37\x74\x36\x7c\x35\xc3\xe1\xf0\xfc\xfa\xda\xab\x56\xa0\x2d\xdf\xbc\x7c\xb9\xe9\x4e\xfd\x23\x1f\xab\x5b\xfd\x60\xdd\x63\xc8\x3d\x84\xf5\xe1\x2f\x5f\x6e\x12\xa8\x10\xbd\x1e\x97\xfa\x14\xa5\xca\x9f\xcb\xfd\xcb\x8b\x60\x1f\x72\xcd\x88\xd3\xbf\x4f\x6e\x1b\x02\x73\x71\xe4\xb6\xa2\x29\xc3\x0f\xe4\x30\xbc\xda\x65\x96\xe2\x17\x1f\x71\x91\xc3\xce\xb5\xdf\xdf\xaf\x44\xe2\x31\x6e\xdb\xac\x28\xa6\xa3\xfd\xf3\x83\xef\x35\x26\x7e\xd3\x21\x58\x85\x50\x63\x71\x69\xef\x93\xab\x73\xa5\x48\xfe\x12\xd7\x16\x29\x3d\xad\x6f\xdf\x9c\x9e\x5f\xf6\x35\xae\x2e\x32\x7a\x72\x32\x7d\x2e\x30\x64\x95\x4e\x54\xfd\x06\x5e\xd1\x87\xbd\x3c\x0c\x9e\xfc\xe8\x5b\xcf\xa2\x5b\xb8\xfc\xb9\xb7\xc0\x2f\xf7\xbf\x4a\xd6\x33\xd1\x3c\x9a\x0e\x1d\xa2\xc2\x5c\x41\x19\x50\x54\x0a\x43\xa3\x69\x06\xbe\xfc\x0b\xac\xca\x8a\x36\x23\x7e\x7a\x76\xf6\x2f\x41\xdc\x8b\x2a\x3e\x17\x73\x7c\xe9\x46\xcc\xed\xf7\xd4\x1e\xc3\x1e\xaf\xde\xe8\x63\x8d\x54\x5f\xc3\x19\xfc\x8e\xf4\x5f\x8b\xd2\x13\x04\xfd\xfd\x51\x0a\x29\xdb\x57\xef\xa7\x6f\x9d\x6a\xef\x1f\x07\x7e\xc6\xce\xd8\x30\x39\x3a\xe7\x8b\xbc\xa1\x07\x80\x1f\x30\x73\x17\x90\x5d\x73\xf0\x39\x93\x15\x67\xeb\xcd\xc5\x4f\xc7\x97\x07\xdf\x7b\x44\x0b\xbf\xa0\x66\x74\x40\x3e\x36\x20\xbc\x4f\x8c\x85\x1d\xab\xb0\xd9\x05\x0d\x94\x4c\xf0\xa1\xb7\x0d\x13\xf1\x5b\x17\x7d\xdd\x4b\x9f\xd0\x78\x52\xf5\x7a\x5f\xc9\xc0\x09\x3d\xc5\xbb\xfa\xc5\x0c\x1a\xb4\xbb\x32\xc8\xfb\x7a\x06\x8d\xf8\xea\xfa\x11\x8f\xed\xbb\xe3\x93\x50\x0b\x9b\xd2\xe5\xcf\x71\xf7\x70\x32\x6c\xa4\xb7\x0e\x82\x5f\xfc\x6c\x21\x0c\xfd\x85\x9b\xc2\xf2\x1e\x9f\xb1\x9e\xfc\x89\x37\xad\x18\x8c\x8d\xa8\x6e\xc0\x22\x82\x90\x75\xa3\x52\xf0\x3f\x04\xd3\xb7\x2b\xcb\xb6\xe8\x30\x81\x31\x91\x6d\xf5\xb4\x65\x79\xf0\xaf\x25\xe0\xa6\x37\x87\x6a\x5f\xc6\xe6\xa6\x65\x42\x57\xc2\xee\xfb\x9f\xca\x41\xef\x84\xd3\x49\x57\x91\x4d\xd2\x44\xd6\x47\x09\x73\x25\xb1\xcd\xb3\x9c\x51\x8e\xc0\x7c\x51\x48\x6f\x7b\x65\x0e\x46\x31\x7b\xd0\xd6\xa5\x29\x22\xe4\xaa\x3a\xaf\x8e\x0f\x67\xae\xd4\xf1\xf9\x95\x7c\xc1\x00\xff\xca\xaf\xb9\xc0\x49\x49\x05\x13\xd7\x8e\xc4\x20\xcb\x21\xd8\xe9\x0a\xd8\xf4\x10\xda\xff\x1b\xb6\x1e\x40\xeb\x24\x9b\x37\x59\x71\x53\x99\xb6\x43\x68\xcb\xe5\x5d\x31\x91\xbf\xa0\xe7\x69\x9a\x4f\xa0\xd9\xe4\xd2\x82\x8e\x4b\xe8\x20\x3f\xd0\xb4\xfa\x95\x11\x8c\xa5\x5e\x79\xaf\x86\xd0\xab\x22\xf4\x97\x42\xdf\x15\xd5\xb5\x2a\x49\x3f\x23\xe2\x8e\x26\xc3\x1a\x14\xbf\x90\xcf\xe6\x80\xf0\x93\xbc\x38\x22\x32\x89\x0c\x70\xf8\x67\x05\x18\x4f\x2c\xfd\xd1\x09\x99\x87\xa1\xf8\xfa\xcf\x3b\xae\xa2\xc5\x78\x9a\x6b\x0a\xff\x36\xc3\xd5\xde\xf0\x1a\xd8\xbb\xdf\xfc\x39\xe9\xa7\xd6\xd6\x1d\xcc\xad\x53\x62\xe1\x17\x55\x4a\x60\x5d\x1c\xfd\x5c\x2f\x40\x6b\xb5\xf5\x12\x98\x28\xf2\x5a\x62\x39\x3d\x5e\x0d\x6a\x1a\xf0\x8d\x5c\x02\x51\xa5\xa6\x08\x3e\xd9\xfa\x3f\xfc\xd6\xbc\xba\x24\x5c\x00\x00")
 
 func assetsJsIndexJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -189,12 +189,12 @@ func assetsJsIndexJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/js/index.js", size: 8836, mode: os.FileMode(436), modTime: time.Unix(1482287885, 0)}
+	info := bindataFileInfo{name: "assets/js/index.js", size: 23588, mode: os.FileMode(436), modTime: time.Unix(1792321530, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsJsLineandbarsJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x55\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x4c\xd3\x02\x96\x5b\xc5\x91\xb3\xe8\x22\x50\xa2\x00\xed\x6e\xdb\x1c\xd2\x6c\xb6\x31\xd0\x83\xe1\x03\x6d\x8e\x2c\x22\x14\xa9\x92\xd4\xda\x4e\x56\xff\xbd\xa0\xa8\x0f\x4a\xbb\x0b\x14\xbd\x24\xd6\x9b\x37\x6f\xc8\x37\x43\xf2\x87\x30\xab\xc4\xce\x30\x29\xc2\x39\xbc\x06\x00\x77\x6c\x9f\xef\x72\xa2\x8c\x5e\x68\x34\x1f\x4a\x1b\xd2\xa1\x8d\x00\xec\xb9\xdc\x12\x9e\x80\xfb\x02\x30\xac\xc0\x17\x29\xf0\x43\x96\x69\x34\x09\x5c\xc1\x8f\xf0\x36\x86\x8b\x0b\x78\x7c\x5a\x35\x9c\x3a\x00\xa8\xe7\xd7\x41\x3d\x0f\x82\xa0\xab\x04\x42\xaa\xe2\x3d\x31\x24\xa4\xc4\x10\x57\xf6\x13\x51\xf0\xf1\xf1\xe9\xfe\xb7\x87\x3f\x56\x77\x90\xc2\x32\x8e\x5b\x54\xc8\x03\xa4\xf0\x9e\x18\x5c\x08\x79\x08\xe7\x01\xd8\x02\x0f\x52\x15\x84\xb3\x17\x04\x23\xbd\x44\x2b\x08\xa5\x64\xc2\xe8\x45\x00\xc0\x32\x08\xbf\x6b\xb0\xcf\x9f\x9b\xd8\x82\xa3\xd8\x9b\x1c\xd2\x34\x85\x78\xde\x6e\xa4\x21\xa4\xb0\x5e\x0b\x79\x88\x20\xde\x6c\x02\xb7\xf0\x43\xce\x38\x42\xe8\xe7\xdd\x0c\xb5\xfc\xec\x45\x25\x74\xce\x32\x13\xae\xed\xd7\x3a\xde\xac\xe3\xcd\xf9\x32\x8e\x63\x2b\x37\xff\xb6\xdc\xed\x37\xe4\x9c\x58\x97\xa8\xd0\x54\x4a\x34\x91\xa0\xf6\x7d\x7c\xc0\xc3\x3b\xdb\xaa\x50\x48\x8a\x11\xe4\xc8\xf6\xb9\x19\xfc\x6c\xda\x18\x74\x36\xf8\x51\x68\xb9\xd6\xe8\xb7\x57\x4d\x99\x00\xc0\xaa\x2c\xfe\x29\x75\xce\xb4\x91\xea\x04\xe9\xd0\xa8\x49\x68\x6e\xe9\x8d\xbc\x25\xe1\xc1\x9f\x1a\xb7\x22\x57\xa5\x81\x86\x79\x51\x28\x28\xaa\x95\x4c\x60\xd6\x44\xce\x67\xf0\x93\xab\xca\x68\xd4\xcd\xd4\xa9\x44\x1b\x97\xbc\x2a\xc4\xac\x43\xb7\x64\xf7\xbc\x57\xb2\x12\xf4\x9d\xe4\x52\x25\x30\x33\x8a\x08\x5d\x12\x85\xc2\xf4\x2c\xb7\xa9\xa4\xfd\xdf\xa1\x05\x51\x7b\x26\xee\x31\x33\x09\xbc\x19\x83\x7f\x39\xfe\x04\xfd\x55\x1a\x23\x8b\x04\xe2\x31\xbc\x92\xa5\x87\xbd\x48\x59\xac\xdc\x5a\x8f\x33\x37\xeb\x2e\x64\x98\xe1\xe8\x9d\x11\x3c\x9a\x04\x66\x23\xca\xe9\x97\x23\xd3\x03\x65\xaf\x18\xbd\x67\x02\xff\x66\xd4\xe4\x09\xc4\x3e\xf5\x38\xa6\xb6\xee\x50\x62\xd0\x1e\xbe\x76\xe7\x2d\x57\xa3\x62\xa8\x13\x58\x77\x6c\x41\x0a\xcb\xfe\xf8\xf8\xd4\x5b\xb4\x6b\xed\xfb\x3e\xcb\xb2\xd9\xc4\x73\xce\x04\xf6\x98\x9d\xb6\x64\x3a\x12\xae\xda\xc6\x71\x76\x0a\x29\x33\xde\xe2\x50\x90\x2d\x47\x9a\x40\x46\xb8\x46\x7f\x65\x1c\xf7\x28\xe8\x7f\x61\x96\x5c\x76\x97\xce\x40\x77\xc3\x30\x7c\x03\x6c\xa5\xa2\xa8\x3a\xc3\xa2\x1e\xef\xb7\xb7\xbd\xdc\x5d\xbd\x59\xce\x86\x88\xce\x09\x95\x07\xbf\x62\x5f\x13\xc0\xee\xdc\x97\xe7\x43\x37\x96\xd1\x94\xdc\xd9\x3c\xd0\x0b\xa2\x9e\x51\xf9\xc8\x74\x8b\x91\x17\x51\x84\xb2\x4a\x7b\xca\x9e\x36\x80\x36\xc4\x8c\xd5\x01\x72\xf9\x69\x2a\x0f\x90\x13\x2e\xa7\x18\x80\x66\x2f\x98\xc0\x65\x34\x82\xeb\xe0\x6b\xbf\xbb\x5f\xf5\x68\x7a\xa5\xe4\x86\x95\x83\xf0\x17\x27\x4f\x54\x9c\xf7\xc7\xf2\xab\x6d\x18\x59\xdd\x81\x95\xc6\xbb\xd5\x9f\xf7\x09\x18\x55\xf5\xa0\x36\x27\x3e\x32\xbe\x24\x94\x32\xb1\xef\x0e\x81\xe7\x4c\x29\x35\xb3\x63\x61\x8d\xe8\x2f\xc0\x70\xee\xe5\xb6\xd7\xe4\x2b\x1c\x13\x58\xfe\x1c\x47\x70\x4a\xe0\xfc\x0a\xea\xeb\x2f\x1a\x38\xad\xda\x4d\xcd\x21\x67\x66\x38\x01\xf5\x70\xad\x10\x8a\xea\x77\xfb\xe2\xd8\xa3\x7c\xa3\x4b\x22\x60\xc7\x89\xd6\xe9\x59\x59\x71\x7e\xae\xec\x35\x72\xe6\x74\xd3\xb3\x4c\x0a\x73\xee\xfa\xb0\x8c\xcb\xe3\xf5\xd9\xed\x6b\xf3\x22\x2d\x9e\xf1\x54\xdf\x5c\xd8\xec\xdb\xd9\xb0\x2d\x26\xcc\xff\x92\xbe\x6c\xa4\xed\x03\x02\xad\xfe\x29\x59\xc4\xd9\xa4\x42\x1d\x05\xcd\x13\xdc\x5f\xd8\x0b\x42\xe9\xa3\xa5\x43\xda\xfb\x18\x36\xf9\x9d\x97\x8e\xe6\xa6\x7c\x1d\x6f\xfa\x04\xc7\x8a\x5c\x03\x9b\xbf\xf3\xee\xe5\x68\xad\x77\x0f\x4e\x1d\xfc\x1b\x00\x00\xff\xff\xea\x98\x72\x15\x55\x08\x00\x00")
+var _assetsJsLineandbarsJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe5\x57\x6d\x6f\xdb\x36\x10\xfe\xee\x5f\x71\xcb\x86\x49\x5e\x64\x47\x4e\xd0\xc2\x51\xe2\x00\x5d\x96\x2d\x03\xbc\x24\x4b\x0c\x0c\x83\xe1\x0f\xb4\x45\xd9\x44\x25\x52\x13\xe9\xc6\x4e\xea\xff\xbe\xa3\xa8\x17\x4a\x49\x8a\xae\xeb\xba\x01\x03\x0c\x51\xba\x3b\x3e\x47\x1e\x8f\xf7\x9c\xbf\x71\xa3\x35\x5f\x28\x26\xb8\xdb\x85\xc7\x0e\xc0\x25\x5b\xae\x16\x2b\x92\x29\xd9\x97\x54\x5d\xa7\x5a\x25\x5d\xad\x01\x58\xc6\x62\x4e\xe2\x00\xcc\x17\x80\x62\x09\x7d\x10\x9c\x5e\x47\x11\xda\x06\x30\x84\xef\xe0\xb5\x0f\x07\x07\x70\x73\x37\xc9\x6d\x76\xf8\xdc\x75\x4f\x3a\xbb\x6e\xa7\xd3\x29\x3d\x01\x17\x59\xf2\x03\x51\xc4\x0d\xf1\x61\xdc\xbe\x23\x19\xfc\x7a\x73\x37\xbe\xb8\xfa\x69\x72\x09\x23\x18\xf8\x7e\x21\xe5\xe2\x1e\xbf\xd1\x9c\xf6\xf1\xd5\xed\xa2\x18\x1d\x5c\x21\x04\x89\xd9\x03\x05\x25\xac\x89\x1a\x10\x52\xc1\x38\x2e\x1f\x0d\x59\x04\xee\x57\xb9\xec\xfd\xfb\x5c\xd7\x8f\x29\x5f\xaa\x15\x8c\x46\x23\xf0\xbb\xc5\x46\x72\x83\x11\x4c\xa7\x88\xef\x81\x3f\x9b\x75\xcc\xc2\xef\x57\x2c\xa6\xe0\xda\xf3\x4e\x6b\x5f\xf6\xec\xfe\x9a\xcb\x15\x8b\x94\x3b\xd5\x5f\x53\x7f\x86\xbf\x1e\x6e\xc1\xd7\x70\xdd\x97\xe1\xce\x5e\x80\x33\x60\xe5\xc4\x8c\xaa\x75\xc6\x73\x4d\x67\x67\xc7\xf1\x8a\xde\x9f\xeb\xa3\x72\xb9\x08\xa9\x07\x2b\x8a\x67\xa7\xea\x78\xe6\xc7\xd8\x29\xc3\x60\x6b\xa1\xb0\xd5\x81\x7e\x3d\xcc\xdd\xe0\x43\xa3\xf4\xff\x48\xd1\xb9\x54\x22\xdb\xa2\xb2\x3a\xa8\x96\xaa\xab\xcd\x73\x78\x6d\x44\xef\xed\xac\x31\x2b\x32\x5e\x72\x51\x9d\x2f\x19\xe5\x21\xcd\x26\x22\x00\x27\xd7\xf4\x1c\xd8\x37\x5e\x59\xe8\x95\x39\xb5\x4d\xa9\xd6\x8b\x78\x9d\x70\xa7\x94\xce\xc9\xe2\xed\x32\x13\x6b\x1e\x9e\x8b\x58\x64\x68\xa0\x32\xc2\x65\x4a\x10\x52\x55\x56\x66\x53\x41\x31\x96\xd2\x84\x64\x4b\xc6\xc7\x34\x42\xcd\x51\x53\x78\x6b\xec\x5b\xd2\xef\x85\x52\x22\x09\xc0\x6f\x8a\x27\x22\xb5\x64\x0f\x42\x24\x13\xb3\xd6\x8d\x63\x72\xdd\xa8\x14\x53\x31\xb5\xee\x08\xdd\xa0\x07\xa7\x61\xb2\x7d\xb3\x61\xb2\x36\x59\x66\x2c\x1c\x33\x4e\x7f\x63\xa1\x5a\xa1\x0b\xdb\x74\xd3\x34\x2d\xa2\x83\xa9\x40\xf5\xe5\x2b\x76\x5e\xd8\x4a\x9a\x31\x8a\xc6\xd3\xd2\x9a\x93\x44\x5b\x63\x8e\x55\x21\x5a\x14\xe1\xfb\x3a\x8a\x22\xa7\x15\xf3\x18\xd7\x50\xc9\x74\xb6\x05\xed\x94\x30\xde\x66\xc6\x66\x91\xd1\x90\x29\x6b\x71\x94\x93\x79\x4c\xc3\x00\x22\x12\x4b\x6a\xaf\x2c\xa6\x4b\x3c\xfa\x8f\xb1\x4c\x63\x51\x16\x9d\xda\xdc\x24\x43\xfd\x8d\xf9\x20\x32\xcc\xa4\x32\x60\x5e\x25\xaf\xb6\x37\x3f\x5c\x0c\x8f\x06\x4e\xad\x91\x2b\x12\x8a\x7b\xdb\x63\xe5\x13\xd7\x87\x3b\xb7\xe1\xe3\xfa\x34\x06\x5e\xdb\xb8\x0c\x73\x6d\x8e\xf9\xf1\x96\x66\xb6\xa4\xbd\x45\xcf\xd2\x64\x24\x64\x6b\x69\x21\x5b\xd8\x88\xae\xf0\x6c\x65\x13\x6b\x25\xde\xb5\xe1\x51\x48\x62\xd1\x96\xe1\x74\x2c\x89\x01\x1c\x7a\x0d\xf1\xae\xf3\xdc\x7b\xf9\xb6\x6b\x64\xaf\x10\xb1\x62\x69\x0d\xfc\xe4\xe6\xf1\x75\x1c\x57\xd7\xf2\xd9\x63\x68\x84\xba\x14\xae\x25\xbd\x9c\xfc\x32\x0e\x40\x65\xeb\x4a\x28\xd5\x36\x6e\x04\x3e\x25\x61\xc8\xf8\xb2\xbc\x04\x56\x64\x52\x21\x99\x4e\x0b\x1d\x88\xaa\x00\xba\x5d\x6b\x6e\x51\x26\x1f\x61\x83\xb1\x7d\x85\xa5\x77\x1b\x40\x6f\x08\xbb\x93\x27\x07\xd8\xf6\x5a\x66\x0d\x56\x68\x55\xdf\x80\x5d\x5d\x56\x08\xee\xf2\x47\xcd\x38\xfa\x2a\x9f\x62\xd9\xe1\xb0\x88\x89\x94\xa3\xbd\x14\xa3\xd1\xcb\x74\x19\xd9\x33\xb8\xa3\xbd\x48\x70\xd5\x33\xe7\x30\xf0\xd3\xcd\xc9\xde\xd9\x63\xce\x48\xfd\xb7\x74\xbb\x3b\x3d\xd0\xb3\xcf\x9c\x7a\x5b\xa8\xf9\x24\xe8\xc3\x1c\x5a\x13\x08\x14\xf8\xdb\xa0\xef\x47\x2d\x0f\xb8\x89\x9c\x82\xab\x82\xdd\xc7\x08\xdf\x68\x73\xac\xdc\x15\xf5\xe7\xf3\xcb\x58\x1a\x33\x93\xe5\xc8\x63\xd5\x04\x63\xe5\x99\x03\xcc\x9f\xdd\x92\x39\x8a\xd0\x1b\xc2\x41\x89\x66\x9f\xf1\x9b\xc9\xc5\xd5\xf9\xef\x77\x17\xb7\x3f\x5f\xdc\x69\x7a\x75\xd2\x57\xbe\xe3\x81\x93\x1e\x17\xc3\x71\x31\xf4\xf3\x97\x84\x6c\x9c\x99\x3d\xf3\xfc\x7a\x7c\x7d\x6b\x66\x56\xd7\xb9\x2c\x5c\x7a\x24\x83\xf0\x30\x34\xaf\x3e\x39\xf2\x49\xfe\x3a\x1c\x0e\x11\xa5\x83\x1d\xc2\x18\xef\x11\x5f\x6c\x8b\x6e\x00\x90\x29\x60\xaa\x6b\x26\x5e\xb0\x24\xf5\x20\xd5\x19\x82\x4b\xd1\x8f\xe3\xfc\xd1\xc7\x01\x17\x31\x03\xc6\x21\xc1\xf6\xc1\xa6\xd9\x02\xec\x73\xb2\xad\x9e\x63\x33\x2d\x16\xda\xd8\x78\x29\xa5\xd8\xb3\x4c\x67\x85\xa5\x39\x0e\x1d\x0c\x2d\x89\x44\x06\x6e\x2e\x46\x89\x7f\x82\xc3\x69\x33\xde\x45\x83\x81\x9a\xfd\xfd\x72\x11\xda\xbe\x6c\x75\x66\xb9\xa4\x82\x61\x06\x86\x21\x4c\xe1\xbc\x02\x60\x35\x40\xd1\x9c\xa4\x6b\xb9\x72\xa7\x57\xeb\x64\x4e\x33\xb7\x30\x9f\x32\xdd\xf2\x74\x3d\x78\x2a\x96\xfb\x83\x59\x37\x6f\x82\xca\x52\x63\xf6\x62\x70\x9a\x54\xd5\xd8\xc3\x54\xce\x5a\xac\xd5\x48\x0c\x4b\xfd\x21\xfe\xb2\x3b\x4d\xb3\x86\x2a\x6b\xff\x56\x03\x83\x67\xf5\x62\x0f\xf3\xbf\xea\x56\x2a\xb7\x8c\x7f\x86\xde\xc5\x8c\x5f\xba\xc1\xf8\xd7\xf9\xff\xa3\xa8\x18\x79\x35\xd3\x88\x36\x83\xfe\xa3\xf4\xfc\xe5\x39\xf7\x43\x1d\xc0\x7f\x99\x86\xbf\xe5\x73\x99\x9e\x3c\x16\x95\x4d\x17\xb3\x9d\xc5\xca\x83\x68\x97\xc8\x27\xbc\xfc\x17\x69\xf9\x93\x6a\x7e\x8b\xcd\xa5\xc5\xe6\xd3\x1c\x1d\xab\xb6\x67\x76\x9f\x17\x6a\xfc\x40\xf8\xd1\xb3\xc0\xd0\xc3\x94\xad\x78\xbf\xf8\x6f\xff\x0c\xfb\xff\x09\x93\xff\xe1\xdc\x53\x10\x00\x00")
 
 func assetsJsLineandbarsJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -209,7 +209,7 @@ func assetsJsLineandbarsJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/js/lineandbars.js", size: 2133, mode: os.FileMode(509), modTime: time.Unix(1481582175, 0)}
+	info := bindataFileInfo{name: "assets/js/lineandbars.js", size: 4179, mode: os.FileMode(509), modTime: time.Unix(1792314582, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,159 @@
+package web
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// User may use the UI and API, logging in with a password (HTTP basic
+// auth) or presenting a token.
+type User struct {
+	Name     string `json:"name"`
+	Password string `json:"password,omitempty"` // bcrypt hash
+	Token    string `json:"token,omitempty"`    // Sent as "Authorization: Bearer <token>", or ?token=
+	Role     string `json:"role"`               // viewer, operator or admin
+
+	role cluster.Role
+}
+
+var (
+	users      []*User // nil when there's no login, and everyone's an admin
+	usersMutex sync.RWMutex
+	checked    = map[[sha256.Size]byte]*User{} // Logins already checked against their bcrypt hash
+
+	errNoLogin = errors.New("Log in, or give a token")
+)
+
+// LoadUsers reads the JSON list of users in path, after which every
+// request needs one of them.
+func LoadUsers(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var list []*User
+	if err := json.Unmarshal(b, &list); err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+	if len(list) == 0 {
+		return fmt.Errorf("%s: no users", path)
+	}
+	seen := map[string]bool{}
+	for _, u := range list {
+		if u.Name == "" || seen[u.Name] {
+			return fmt.Errorf("%s: missing or repeated user name %q", path, u.Name)
+		}
+		seen[u.Name] = true
+		if u.Password == "" && u.Token == "" {
+			return fmt.Errorf("%s: user %s needs a password or a token", path, u.Name)
+		}
+		if u.role, err = cluster.ParseRole(u.Role); err != nil {
+			return fmt.Errorf("%s: user %s: %s", path, u.Name, err)
+		}
+	}
+	usersMutex.Lock()
+	users = list
+	checked = map[[sha256.Size]byte]*User{}
+	usersMutex.Unlock()
+	return nil
+}
+
+// HashPassword gives the bcrypt hash of password, for a users file.
+func HashPassword(password string) (string, error) {
+	b, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(b), err
+}
+
+// Authenticate says which user made r.
+func Authenticate(r *http.Request) (*User, error) {
+	usersMutex.RLock()
+	list := users
+	usersMutex.RUnlock()
+	if token := requestToken(r); token != "" {
+		for _, u := range list {
+			if u.Token != "" && subtle.ConstantTimeCompare([]byte(u.Token), []byte(token)) == 1 {
+				return u, nil
+			}
+		}
+		return nil, errors.New("Unknown token")
+	}
+	name, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, errNoLogin
+	}
+	// bcrypt is slow on purpose, and the UI makes a lot of requests.
+	key := sha256.Sum256([]byte(name + "\x00" + password))
+	usersMutex.RLock()
+	u := checked[key]
+	usersMutex.RUnlock()
+	if u != nil {
+		return u, nil
+	}
+	for _, u := range list {
+		if u.Name == name && u.Password != "" &&
+			bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) == nil {
+			usersMutex.Lock()
+			checked[key] = u
+			usersMutex.Unlock()
+			return u, nil
+		}
+	}
+	return nil, errors.New("Wrong user name or password")
+}
+
+func requestToken(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	return r.URL.Query().Get("token")
+}
+
+// RoleOf is the role of whoever made r: an admin if there's no login.
+func RoleOf(r *http.Request) cluster.Role {
+	usersMutex.RLock()
+	open := users == nil
+	usersMutex.RUnlock()
+	if open {
+		return cluster.Admin
+	}
+	if u, err := Authenticate(r); err == nil {
+		return u.role
+	}
+	return cluster.NoRole
+}
+
+// guard lets through requests from users who may: viewers for GETs,
+// and those with role need for anything else.
+func guard(need cluster.Role, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		want := need
+		if r.Method == "GET" || r.Method == "HEAD" {
+			want = cluster.Viewer
+		}
+		switch role := RoleOf(r); {
+		case role == cluster.NoRole:
+			w.Header().Set("WWW-Authenticate", `Basic realm="hitter"`)
+			http.Error(w, errNoLogin.Error(), http.StatusUnauthorized)
+		case role < want:
+			http.Error(w, fmt.Sprintf("%s %s needs the %s role", r.Method, r.URL.Path, want), http.StatusForbidden)
+		default:
+			handler(w, r)
+		}
+	}
+}
+
+// serveWs upgrades the UI's websocket, its messages to be checked
+// against the user's role.
+func serveWs(w http.ResponseWriter, r *http.Request) {
+	cluster.WS.ServeWs(w, r, RoleOf(r))
+}
@@ -3,17 +3,41 @@ package web
 import (
 	"github.com/lyfe-mobile/hitter/cluster"
 	"github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/engine"
 )
 
 func LoadData() interface{} {
+	bulkSize, bulkOrdered, bulkByBatch := engine.Bulk()
 	data := struct {
-		QPSTarget int
-		Procs     int
-		WhichDB   string
+		QPSTarget      int
+		ClusterQPS     int
+		Procs          int
+		WhichDB        string
+		DBTargets      []common.DBTarget
+		WriteConcern   string
+		WriteConcerns  []string
+		AuthMechanisms []string
+		BulkSize       int
+		BulkOrdered    bool
+		BulkByBatch    bool
+		ReadQPS        int
+		ReadRatio      float64
+		Guardrails     string
 	}{
-		Procs:     cluster.PROCS,
-		QPSTarget: cluster.PERSEC,
-		WhichDB:   common.WHICHDB,
+		Procs:          cluster.PROCS,
+		QPSTarget:      cluster.PERSEC,
+		ClusterQPS:     cluster.CLUSTERQPS,
+		WhichDB:        common.WHICHDB,
+		DBTargets:      common.Targets().Redacted().Targets,
+		WriteConcern:   common.WRITECONCERN,
+		WriteConcerns:  common.WriteConcerns,
+		AuthMechanisms: common.AuthMechanisms,
+		BulkSize:       bulkSize,
+		BulkOrdered:    bulkOrdered,
+		BulkByBatch:    bulkByBatch,
+		ReadQPS:        engine.READQPS,
+		ReadRatio:      engine.READRATIO,
+		Guardrails:     engine.GuardrailSettings(),
 	}
 	return data
 }
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+)
+
+// GossipKeys rotates the keys the cluster's gossip is encrypted with.
+// Keys themselves are never shown, only their IDs.
+//
+//	GET  /gossipkeys/   this node's key IDs, the one in use first
+//	POST /gossipkeys/   a JSON KeyChange for every node; installing
+//	                    with no key makes a new one, given back once
+func GossipKeys(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		writeJSON(w, map[string]interface{}{"node": cluster.Clus.Name, "keys": cluster.Clus.Keys()})
+	case "POST":
+		if cluster.Clus.Keys() == nil {
+			http.Error(w, "Gossip isn't encrypted; restart with keys to encrypt it", http.StatusConflict)
+			return
+		}
+		var change cluster.KeyChange
+		if err := json.NewDecoder(r.Body).Decode(&change); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result := map[string]interface{}{"op": change.Op}
+		if change.Op == "install" && change.Key == "" {
+			var err error
+			if change.Key, err = cluster.GenerateKey(); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			result["key"] = change.Key
+		}
+		key, err := cluster.ParseKey(change.Key)
+		if err == nil {
+			err = cluster.Clus.SendEngine("GOSSIPKEY", change)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result["id"] = cluster.KeyID(key)
+		writeJSON(w, result)
+	default:
+		http.Error(w, "GET or POST only", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,92 @@
+package web
+
+import (
+	"sync"
+
+	"github.com/bradfitz/slice"
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/engine"
+	"github.com/lyfe-mobile/hitter/stats"
+)
+
+// How long to wait on slow or dead nodes before charting a second's
+// cluster latency without them.
+const latencyWaitMS = 10000
+
+type pendingLatency struct {
+	hist  *stats.Histogram
+	nodes int
+}
+
+// Per-second histograms still waiting on the rest of the cluster.
+var latencyMerge = map[uint64]*pendingLatency{}
+
+// Everything the cluster has reported since we started, for /metrics.
+var (
+	clusterLatencyTotal      = stats.NewHistogram()
+	clusterLatencyTotalMutex sync.Mutex
+)
+
+// The latest read stats from each node.
+var (
+	nodeReads      = map[string]engine.ReadStats{}
+	nodeReadsMutex sync.Mutex
+)
+
+// latencyPoint is what gets charted: timestamp then p50, p90, p99,
+// p99.9 and max in milliseconds.
+func latencyPoint(ts uint64, hist *stats.Histogram) []float64 {
+	p := hist.Percentiles()
+	return []float64{float64(ts), p.P50, p.P90, p.P99, p.P999, p.Max}
+}
+
+// Charts a second's latency for the node and folds it into that
+// second's cluster-wide histogram.
+func consumeLatency(node string, report engine.LatencyReport) {
+	ts, hist := report.TS, report.Hist
+	if hist == nil {
+		cluster.Log("Latency message has no histogram")
+		return
+	}
+	datapoint := latencyPoint(ts, hist)
+	cluster.Clus.ConfigMutex.Lock()
+	cluster.Clus.Latency.Append(node, datapoint)
+	cluster.Clus.ConfigMutex.Unlock()
+	cluster.WS.WriteJSON(map[string]interface{}{
+		"type":  "LATENCY",
+		"node":  node,
+		"value": datapoint,
+	})
+
+	pending, ok := latencyMerge[ts]
+	if !ok {
+		pending = &pendingLatency{hist: stats.NewHistogram()}
+		latencyMerge[ts] = pending
+	}
+	pending.hist.Merge(hist)
+	pending.nodes++
+	clusterLatencyTotalMutex.Lock()
+	clusterLatencyTotal.Merge(hist)
+	clusterLatencyTotalMutex.Unlock()
+
+	members := cluster.Clus.Count()
+	var ready []uint64
+	for pts, p := range latencyMerge {
+		if p.nodes >= members || pts+latencyWaitMS < ts {
+			ready = append(ready, pts)
+		}
+	}
+	slice.Sort(ready, func(i, j int) bool { return ready[i] < ready[j] })
+	for _, pts := range ready {
+		datapoint := latencyPoint(pts, latencyMerge[pts].hist)
+		delete(latencyMerge, pts)
+		cluster.Clus.ConfigMutex.Lock()
+		cluster.Clus.Latency.Append(cluster.ClusterKey, datapoint)
+		cluster.Clus.ConfigMutex.Unlock()
+		cluster.WS.WriteJSON(map[string]interface{}{
+			"type":  "LATENCY",
+			"node":  cluster.ClusterKey,
+			"value": datapoint,
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/engine"
+	"github.com/lyfe-mobile/hitter/stats"
+)
+
+// Upper bounds of the latency buckets given to Prometheus.
+var metricBuckets = []time.Duration{
+	500 * time.Microsecond,
+	time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Writes metrics in the Prometheus text exposition format.
+type metricWriter struct {
+	w    io.Writer
+	seen map[string]bool
+}
+
+func (m *metricWriter) header(name, kind, help string) {
+	if m.seen[name] {
+		return
+	}
+	m.seen[name] = true
+	fmt.Fprintf(m.w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func (m *metricWriter) labels(pairs ...string) string {
+	var ls []string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		ls = append(ls, fmt.Sprintf("%s=%q", pairs[i], pairs[i+1]))
+	}
+	if len(ls) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(ls, ",") + "}"
+}
+
+func (m *metricWriter) sample(name, kind, help string, value interface{}, labels ...string) {
+	m.header(name, kind, help)
+	fmt.Fprintf(m.w, "%s%s %v\n", name, m.labels(labels...), value)
+}
+
+func (m *metricWriter) counts(prefix string, counts stats.Counts) {
+	m.sample(prefix+"ops_attempted_total", "counter", "Mongo operations attempted.", counts.Attempted)
+	m.sample(prefix+"ops_succeeded_total", "counter", "Mongo operations that succeeded.", counts.Succeeded)
+	m.sample(prefix+"ops_failed_total", "counter", "Mongo operations given up on.", counts.Failed)
+	m.sample(prefix+"ops_retried_total", "counter", "Mongo operations retried after an error.", counts.Retried)
+	for _, class := range stats.ErrorClasses {
+		m.sample(prefix+"errors_total", "counter", "Mongo errors by class.", counts.Errors[class], "class", class)
+	}
+}
+
+func (m *metricWriter) histogram(name, help string, h *stats.Histogram) {
+	m.header(name, "histogram", help)
+	for _, le := range metricBuckets {
+		fmt.Fprintf(m.w, "%s_bucket%s %d\n", name, m.labels("le", fmt.Sprint(le.Seconds())), h.CountBelow(le))
+	}
+	fmt.Fprintf(m.w, "%s_bucket%s %d\n", name, m.labels("le", "+Inf"), h.Total)
+	fmt.Fprintf(m.w, "%s_sum%s %v\n", name, m.labels(), float64(h.Sum)/1e6)
+	fmt.Fprintf(m.w, "%s_count%s %d\n", name, m.labels(), h.Total)
+}
+
+// Metrics serves this node's numbers for Prometheus to scrape. The
+// master also serves hitter_cluster_* series for the whole cluster,
+// so one scrape target covers everything.
+func Metrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m := &metricWriter{w: w, seen: map[string]bool{}}
+
+	running := 0
+	if engine.Running {
+		running = 1
+	}
+	m.sample("hitter_running", "gauge", "Whether this node's engine is running.", running)
+	m.sample("hitter_qps", "gauge", "Successful Mongo operations in the last second.", atomic.LoadUint64(&engine.LastQPS))
+	m.sample("hitter_target_qps", "gauge", "Target operations per second.", cluster.PERSEC)
+	m.sample("hitter_inflight_ops", "gauge", "Mongo operations waiting on a reply.", atomic.LoadInt64(&engine.InFlight))
+	m.sample("hitter_procs", "gauge", "Replay goroutines running.", engine.NumProcs())
+	m.sample("hitter_target_procs", "gauge", "Replay goroutines wanted.", cluster.PROCS)
+	colls := make([]string, 0, len(engine.CollOps))
+	for coll := range engine.CollOps {
+		colls = append(colls, coll)
+	}
+	sort.Strings(colls)
+	for _, coll := range colls {
+		m.sample("hitter_collection_ops_total", "counter", "Successful Mongo operations by collection.",
+			atomic.LoadUint64(engine.CollOps[coll]), "collection", coll)
+	}
+	m.counts("hitter_", engine.Errors.Counts())
+	m.histogram("hitter_latency_seconds", "Latency of Mongo operations.", engine.LatencyTotal())
+	m.sample("hitter_read_qps", "gauge", "Dashboard queries answered in the last second.", atomic.LoadUint64(&engine.LastReadQPS))
+	m.sample("hitter_read_target_qps", "gauge", "Target dashboard queries per second.", engine.ReadRate())
+	m.sample("hitter_read_skipped_total", "counter", "Dashboard queries not issued because every reader was busy.", atomic.LoadUint64(&engine.ReadsSkipped))
+	m.counts("hitter_read_", engine.ReadErrors.Counts())
+	m.histogram("hitter_read_latency_seconds", "Latency of dashboard queries.", engine.ReadLatencyTotal())
+
+	if !cluster.Clus.AmMaster() {
+		return
+	}
+	var qps uint64
+	var counts stats.Counts
+	cluster.Clus.ConfigMutex.RLock()
+	for _, member := range cluster.Clus.Members.Members() {
+		counts.Add(cluster.Clus.Errors[member.Name])
+		if len(cluster.Clus.Qps[member.Name]) == 0 {
+			continue
+		}
+		if _, last, ok := qpsPoint(cluster.Clus.Qps.Last(member.Name)); ok {
+			qps += last
+		}
+	}
+	cluster.Clus.ConfigMutex.RUnlock()
+	m.sample("hitter_cluster_nodes", "gauge", "Members of the cluster.", cluster.Clus.Count())
+	m.sample("hitter_cluster_qps", "gauge", "Successful Mongo operations in the last second across the cluster.", qps)
+	var readQPS uint64
+	nodeReadsMutex.Lock()
+	for _, member := range cluster.Clus.Members.Members() {
+		readQPS += nodeReads[member.Name].QPS
+	}
+	nodeReadsMutex.Unlock()
+	m.sample("hitter_cluster_read_qps", "gauge", "Dashboard queries answered in the last second across the cluster.", readQPS)
+	m.counts("hitter_cluster_", counts)
+	clusterLatencyTotalMutex.Lock()
+	total := clusterLatencyTotal.Copy()
+	clusterLatencyTotalMutex.Unlock()
+	m.histogram("hitter_cluster_latency_seconds", "Latency of Mongo operations across the cluster.", total)
+}
@@ -0,0 +1,45 @@
+package web
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/engine"
+)
+
+// Profile runs a posted load profile from this node (POST), stops it
+// (DELETE) or says how far along it is (GET).
+func Profile(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		p, err := engine.ParseProfile(b)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := engine.StartProfile(p); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	case "DELETE":
+		engine.StopProfile()
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		b, err := json.Marshal(engine.CurrentProfile())
+		if err != nil {
+			cluster.Log("Marshaling profile JSON: %s", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	}
+}
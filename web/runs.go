@@ -0,0 +1,174 @@
+package web
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/engine"
+)
+
+var runsTemplate = template.Must(template.New("runs").Parse(`<!doctype html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <title>Runs</title>
+    <link rel="stylesheet" href="//maxcdn.bootstrapcdn.com/bootstrap/3.3.7/css/bootstrap.min.css">
+  </head>
+  <body class="container">
+    <h1>Runs on {{.Node}}</h1>
+    <table class="table table-condensed">
+      <tr><th>Run</th><th>Seconds</th><th>Mean QPS</th><th>Peak QPS</th><th>p99 ms</th><th>Reads/sec</th><th>Errors</th><th>DB</th><th>Source</th></tr>
+      {{range .Runs}}
+      <tr>
+        <td><a href="{{.ID}}">{{.ID}}</a> (<a href="{{.ID}}.json">json</a>)</td>
+        <td>{{printf "%.0f" .Summary.Seconds}}</td>
+        <td>{{printf "%.1f" .Summary.MeanQPS}}</td>
+        <td>{{.Summary.PeakQPS}}</td>
+        <td>{{printf "%.1f" .Summary.Latency.P99}}</td>
+        <td>{{printf "%.1f" .Summary.MeanReadQPS}}</td>
+        <td>{{.Summary.Counts.TotalErrors}}</td>
+        <td>{{.Config.WhichDB}}</td>
+        <td>{{.Config.Source}}</td>
+      </tr>
+      {{end}}
+    </table>
+  </body>
+</html>
+`))
+
+var runTemplate = template.Must(template.New("run").Parse(`<!doctype html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <title>Run {{.ID}}</title>
+    <link rel="stylesheet" href="//maxcdn.bootstrapcdn.com/bootstrap/3.3.7/css/bootstrap.min.css">
+    <script src="//code.jquery.com/jquery-latest.js"></script>
+    <script src="//code.highcharts.com/highcharts.js"></script>
+  </head>
+  <body class="container">
+    <h1>Run {{.ID}}</h1>
+    <p><a href="./">All runs</a> · <a href="{{.ID}}.json">JSON</a></p>
+    <div class="row">
+      <div class="col-md-6">
+        <h3>Config</h3>
+        <table class="table table-condensed">
+          <tr><th>Node</th><td>{{.Node}}</td></tr>
+          <tr><th>Start</th><td>{{.Start}}</td></tr>
+          <tr><th>End</th><td>{{.End}}</td></tr>
+          <tr><th>DB</th><td>{{.Config.WhichDB}} ({{.Config.Database}})</td></tr>
+          <tr><th>Write concern</th><td>{{.Config.WriteConcern}}</td></tr>
+          <tr><th>Bulk</th><td>{{.Config.Bulk}}</td></tr>
+          <tr><th>Procs</th><td>{{.Config.Procs}}</td></tr>
+          <tr><th>QPS target</th><td>{{.Config.PerSec}}{{if .Config.ClusterQPS}} (of {{.Config.ClusterQPS}} for the cluster){{end}}</td></tr>
+          <tr><th>Collections</th><td>{{range .Config.Collections}}{{.}} {{end}}</td></tr>
+          <tr><th>Source</th><td>{{.Config.Source}}</td></tr>
+          <tr><th>Reads</th><td>{{.Config.Reads}}</td></tr>
+          {{if .Config.Profile}}<tr><th>Profile</th><td>{{.Config.Profile}}</td></tr>{{end}}
+          {{if .Aborted}}<tr class="danger"><th>Aborted</th><td>{{.Aborted}}</td></tr>{{end}}
+        </table>
+      </div>
+      <div class="col-md-6">
+        <h3>Summary</h3>
+        <table class="table table-condensed">
+          <tr><th>Seconds</th><td>{{printf "%.1f" .Summary.Seconds}}</td></tr>
+          <tr><th>Operations</th><td>{{.Summary.Operations}}</td></tr>
+          <tr><th>Mean QPS</th><td>{{printf "%.1f" .Summary.MeanQPS}}</td></tr>
+          <tr><th>Peak QPS</th><td>{{.Summary.PeakQPS}}</td></tr>
+          <tr><th>Latency ms</th><td>p50 {{.Summary.Latency.P50}}, p90 {{.Summary.Latency.P90}},
+            p99 {{.Summary.Latency.P99}}, p99.9 {{.Summary.Latency.P999}}, max {{.Summary.Latency.Max}}</td></tr>
+          <tr><th>Operations</th><td>{{.Summary.Counts.Attempted}} attempted, {{.Summary.Counts.Succeeded}} succeeded,
+            {{.Summary.Counts.Retried}} retried, {{.Summary.Counts.Failed}} failed</td></tr>
+          <tr><th>Errors</th><td>{{range $class, $n := .Summary.Counts.Errors}}{{if $n}}{{$class}} {{$n}} {{end}}{{end}}</td></tr>
+          {{if .Summary.Reads}}
+          <tr><th>Reads</th><td>{{.Summary.Reads}} ({{printf "%.1f" .Summary.MeanReadQPS}}/sec),
+            {{.Summary.ReadCounts.Failed}} failed</td></tr>
+          <tr><th>Read latency ms</th><td>p50 {{.Summary.ReadLatency.P50}}, p90 {{.Summary.ReadLatency.P90}},
+            p99 {{.Summary.ReadLatency.P99}}, p99.9 {{.Summary.ReadLatency.P999}}, max {{.Summary.ReadLatency.Max}}</td></tr>
+          {{end}}
+        </table>
+      </div>
+    </div>
+    <div id="chart"></div>
+    <script>
+      var series = {{.Series}} || []
+      var qps = [], p99 = [], errors = [], reads = [], readp99 = []
+      for (var i = 0; i < series.length; i++) {
+        qps.push([series[i].ts, series[i].qps])
+        p99.push([series[i].ts, series[i].latency.p99])
+        errors.push([series[i].ts, series[i].errors])
+        reads.push([series[i].ts, series[i].reads])
+        readp99.push([series[i].ts, series[i].readlatency.p99])
+      }
+      new Highcharts.Chart({
+        chart: {renderTo: 'chart', zoomType: 'x'},
+        title: {text: ''},
+        credits: {enabled: false},
+        xAxis: {type: 'datetime'},
+        yAxis: [{title: {text: 'QPS'}}, {title: {text: 'ms p99'}, opposite: true}],
+        series: [
+          {name: 'QPS', data: qps},
+          {name: 'p99', data: p99, yAxis: 1},
+          {name: 'Errors', data: errors, type: 'column'},
+          {name: 'Reads', data: reads},
+          {name: 'Read p99', data: readp99, yAxis: 1}
+        ]
+      })
+    </script>
+  </body>
+</html>
+`))
+
+// Runs lists this node's saved runs at /runs/ and reports on one at
+// /runs/<id>, as HTML or, with .json on the end, JSON.
+func Runs(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/runs/")
+	asJSON := strings.HasSuffix(id, ".json") || r.FormValue("format") == "json"
+	id = strings.TrimSuffix(id, ".json")
+
+	var data interface{}
+	var tmpl *template.Template
+	if id == "" {
+		runs, err := engine.Runs()
+		if err != nil {
+			cluster.Log("Listing runs: %s", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data, tmpl = runs, runsTemplate
+		if !asJSON {
+			data = map[string]interface{}{"Node": cluster.HostName, "Runs": runs}
+		}
+	} else {
+		run, err := engine.LoadRun(id)
+		if os.IsNotExist(err) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			cluster.Log("Loading run %s: %s", id, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data, tmpl = run, runTemplate
+	}
+
+	if !asJSON {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := tmpl.Execute(w, data); err != nil {
+			cluster.Log("Rendering runs: %s", err)
+		}
+		return
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		cluster.Log("Marshaling runs JSON: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
@@ -0,0 +1,60 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+)
+
+// Schedule arms every node to start together (POST), optionally
+// stopping together too, or disarms them (DELETE). The instant comes
+// from this node's clock so the browser's doesn't matter. Form values:
+//
+//	in:  how long from now to start, "10s" by default
+//	for: how long to run before stopping, if at all
+func Schedule(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "DELETE" {
+		cluster.Clus.SendEngine("DISARM")
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	if r.Method != "POST" {
+		http.Error(w, "POST or DELETE only", http.StatusMethodNotAllowed)
+		return
+	}
+	in, runFor := 10*time.Second, time.Duration(0)
+	var err error
+	if v := r.FormValue("in"); v != "" {
+		if in, err = time.ParseDuration(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if v := r.FormValue("for"); v != "" {
+		if runFor, err = time.ParseDuration(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	response := map[string]int64{}
+	start := time.Now().Add(in)
+	response["start"] = start.UnixNano()
+	cluster.Clus.SendEngine("STARTAT", start.UnixNano())
+	if runFor > 0 {
+		stop := start.Add(runFor)
+		response["stop"] = stop.UnixNano()
+		cluster.Clus.SendEngine("STOPAT", stop.UnixNano())
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		cluster.Log("Marshaling schedule JSON: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
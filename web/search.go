@@ -0,0 +1,37 @@
+package web
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/lyfe-mobile/hitter/engine"
+)
+
+// Search starts a max-throughput search from this node with a posted
+// JSON SearchConfig (POST), stops it (DELETE) or gives the running or
+// last search, with its steps (GET).
+func Search(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		c, err := engine.ParseSearchConfig(b)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := engine.StartSearch(*c); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	case "DELETE":
+		engine.StopSearch()
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeJSON(w, engine.LastSearch())
+	}
+}
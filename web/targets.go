@@ -0,0 +1,133 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/common"
+	mgo "gopkg.in/mgo.v2"
+)
+
+// DBTargets manages the databases hitter can load. Changes go to every
+// node. Passwords are never shown; a URI given back with the password
+// still hidden keeps the old one.
+//
+//	GET /dbtargets/              lists the targets
+//	PUT /dbtargets/<name>        adds or replaces one from a JSON DBTarget
+//	DELETE /dbtargets/<name>     removes one
+//	POST /dbtargets/<name>/test  connects to one from this node, or to
+//	                             the JSON DBTarget posted if there is one
+func DBTargets(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/dbtargets/")
+	test := strings.HasSuffix(name, "/test")
+	name = strings.TrimSuffix(name, "/test")
+	set := common.Targets()
+	switch {
+	case name == "" && r.Method == "GET":
+		writeJSON(w, map[string]interface{}{"current": common.WHICHDB, "targets": set.Redacted().Targets})
+	case name == "":
+		http.Error(w, "Which DB target?", http.StatusBadRequest)
+	case test && r.Method == "POST":
+		target, ok := set.Find(name)
+		if r.ContentLength != 0 {
+			var err error
+			if target, err = readTarget(r, name, set); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		} else if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, testTarget(target))
+	case r.Method == "PUT" || r.Method == "POST":
+		target, err := readTarget(r, name, set)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := sendTargets(set.With(target)); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		cluster.Log("Saved DB target %s", name)
+		writeJSON(w, target.Redacted())
+	case r.Method == "DELETE":
+		if _, ok := set.Find(name); !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if name == common.WHICHDB {
+			http.Error(w, "Can't remove the DB target in use", http.StatusConflict)
+			return
+		}
+		if err := sendTargets(set.Without(name)); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		cluster.Log("Removed DB target %s", name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// readTarget decodes the target in a request body, putting back the
+// password if it came back hidden.
+func readTarget(r *http.Request, name string, set common.TargetSet) (common.DBTarget, error) {
+	var target common.DBTarget
+	if err := json.NewDecoder(r.Body).Decode(&target); err != nil {
+		return target, err
+	}
+	target.Name = name
+	if old, ok := set.Find(name); ok && target.URI == old.Redacted().URI {
+		target.URI = old.URI
+	}
+	return target, target.Validate()
+}
+
+// sendTargets gives every node set. It carries the passwords, so it
+// only goes to other nodes when gossip is encrypted.
+func sendTargets(set common.TargetSet) error {
+	if !cluster.Clus.Encrypted() && cluster.Clus.Count() > 1 {
+		return fmt.Errorf("DB targets carry passwords, so they're only sent to other nodes with -gossipkeys set")
+	}
+	cluster.Clus.SendEngine("DBTARGETS", set)
+	return nil
+}
+
+// testTarget connects to target and pings it.
+func testTarget(target common.DBTarget) map[string]interface{} {
+	result := map[string]interface{}{"name": target.Name, "node": cluster.HostName, "ok": false}
+	info, err := target.DialInfo()
+	if err != nil {
+		result["error"] = err.Error()
+		return result
+	}
+	info.Timeout = 5 * time.Second
+	start := time.Now()
+	session, err := mgo.DialWithInfo(info)
+	if err != nil {
+		result["error"] = err.Error()
+		return result
+	}
+	defer session.Close()
+	session.SetSocketTimeout(5 * time.Second)
+	if err := session.Ping(); err != nil {
+		result["error"] = err.Error()
+		return result
+	}
+	result["ok"] = true
+	result["millis"] = float64(time.Since(start)) / float64(time.Millisecond)
+	if build, err := session.BuildInfo(); err == nil {
+		result["version"] = build.Version
+	}
+	if names, err := session.DB(target.Database).CollectionNames(); err == nil {
+		result["collections"] = len(names)
+	}
+	return result
+}
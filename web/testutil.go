@@ -0,0 +1,13 @@
+// +build test
+
+package web
+
+import "crypto/sha256"
+
+// OpenLogins forgets every user, after which no one needs to log in.
+func OpenLogins() {
+	usersMutex.Lock()
+	users = nil
+	checked = map[[sha256.Size]byte]*User{}
+	usersMutex.Unlock()
+}
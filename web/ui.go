@@ -4,41 +4,54 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strconv"
-	"strings"
 
 	"github.com/bradfitz/slice"
 	"github.com/braintree/manners"
 	"github.com/lyfe-mobile/hitter/cluster"
 	"github.com/lyfe-mobile/hitter/common"
+	"github.com/lyfe-mobile/hitter/engine"
+	"github.com/lyfe-mobile/hitter/stats"
 )
 
 func AmazonHealth(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, "OK")
 }
 
+// qpsPoint unpacks a QPS datapoint, which is a []uint64 if it came
+// from a message or a []interface{} of json.Numbers if it was
+// gossiped in. Anything else isn't ok.
+func qpsPoint(point interface{}) (ts, qps uint64, ok bool) {
+	switch b := point.(type) {
+	case []uint64:
+		return b[0], b[1], true
+	case []interface{}:
+		t, err := b[0].(json.Number).Int64()
+		if err != nil {
+			return
+		}
+		q, err := b[1].(json.Number).Int64()
+		if err != nil {
+			return
+		}
+		return uint64(t), uint64(q), true
+	}
+	return
+}
+
 func ClusterState(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, clusterState())
+}
+
+// clusterState is everything this node knows about the cluster.
+func clusterState() map[string]interface{} {
 	nodes := make([]map[string]interface{}, 0)
 	qpssums := make(map[uint64]uint64)
 	for _, member := range cluster.Clus.Members.Members() {
 		node := cluster.Clus.NewNode(member)
 		a := node["qpshistory"].([]interface{})
 		for i := 0; i < len(a); i++ {
-			switch b := a[i].(type) {
-			case []uint64:
-				qpssums[b[0]] += b[1]
-			case []interface{}:
-				ts, err := b[0].(json.Number).Int64()
-				if err != nil {
-					continue
-				}
-				qps, err := b[1].(json.Number).Int64()
-				if err != nil {
-					continue
-				}
-				qpssums[uint64(ts)] += uint64(qps)
-			default:
-				fmt.Printf("NO CLUE!!!!!!! %#v\n", a[i])
+			if ts, qps, ok := qpsPoint(a[i]); ok {
+				qpssums[ts] += qps
 			}
 		}
 		nodes = append(nodes, node)
@@ -55,22 +68,28 @@ func ClusterState(w http.ResponseWriter, r *http.Request) {
 		return nodes[i]["name"].(string) < nodes[j]["name"].(string)
 	})
 
-	response := map[string]interface{}{
-		"qpstarget": cluster.PERSEC,
-		"numprocs":  cluster.PROCS,
-		"nodes":     nodes,
-		"qpsdata":   sortedQps,
+	cluster.Clus.ConfigMutex.RLock()
+	latencydata := cluster.Clus.Latency[cluster.ClusterKey]
+	var errors stats.Counts
+	for _, node := range nodes {
+		errors.Add(cluster.Clus.Errors[node["name"].(string)])
 	}
+	cluster.Clus.ConfigMutex.RUnlock()
 
-	b, err := json.Marshal(response)
-	if err != nil {
-		cluster.Log("Marshaling state JSON: %s", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+	return map[string]interface{}{
+		"qpstarget":    cluster.PERSEC,
+		"clusterqps":   cluster.CLUSTERQPS,
+		"numprocs":     cluster.PROCS,
+		"whichdb":      common.WHICHDB,
+		"writeconcern": common.WRITECONCERN,
+		"bulk":         engine.BulkSettings(),
+		"reads":        engine.ReadSettings(),
+		"nodes":        nodes,
+		"qpsdata":      sortedQps,
+		"latencydata":  latencydata,
+		"errors":       errors,
+		"deliveries":   cluster.Clus.Deliveries(),
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.Write(b)
 }
 
 func StatsMessageConsumer() {
@@ -80,83 +99,112 @@ func StatsMessageConsumer() {
 			manners.Close()
 			return
 		}
-		cmds := strings.SplitN(string(msg), " ", 3)
-		if len(cmds) < 3 {
-			cmds = append(cmds, "")
+		if !msg.For(cluster.Clus.Name, cluster.Clus.Labels) {
+			continue
 		}
-		cmd, node, value := cmds[0], cmds[1], cmds[2]
+		engine.NoteSearchMessage(msg)
+		cmd, node := msg.Type, msg.Sender
+		message := map[string]interface{}{
+			"type": cmd,
+			"node": node,
+		}
+		var value interface{}
 		switch cmd {
-		case "STARTED":
-			cluster.Clus.ConfigMutex.Lock()
-			cluster.Clus.States[node] = "play"
-			cluster.Clus.ConfigMutex.Unlock()
-			message := map[string]interface{}{
-				"type": cmd,
-				"node": node,
+		case "STARTED", "STOPPED":
+			state := "play"
+			if cmd == "STOPPED" {
+				state = "stop"
 			}
-			cluster.WS.WriteJSON(message)
-		case "STOPPED":
 			cluster.Clus.ConfigMutex.Lock()
-			cluster.Clus.States[node] = "stop"
+			cluster.Clus.States[node] = state
 			cluster.Clus.ConfigMutex.Unlock()
-			message := map[string]interface{}{
-				"type": cmd,
-				"node": node,
-			}
-			cluster.WS.WriteJSON(message)
-		case "COLLSTOPPED":
-			fallthrough
-		case "COLLSTARTED":
-			message := map[string]interface{}{
-				"type": cmd,
-				"node": node,
-				"coll": cmds[2],
-			}
-			cluster.WS.WriteJSON(message)
+		case "COLLSTOPPED", "COLLSTARTED":
+			var coll string
+			msg.Decode(&coll)
+			message["coll"] = coll
 		case "DBSWITCHED":
-			message := map[string]interface{}{
-				"type": cmd,
-				"db":   value,
-			}
-			cluster.WS.WriteJSON(message)
+			var db string
+			msg.Decode(&db)
+			message["db"] = db
+		case "PROFILE", "PROFILESTAGE", "PROFILEDONE":
+			var progress engine.ProfileProgress
+			msg.Decode(&progress)
+			value = progress
+		case "SEARCH", "SEARCHDONE":
+			var result engine.SearchResult
+			msg.Decode(&result)
+			value = result
+		case "ERROR":
+			var reply cluster.ErrorReply
+			msg.Decode(&reply)
+			message["id"] = msg.ID
+			value = reply
 		case "LOG":
+			var line string
+			msg.Decode(&line)
 			cluster.Clus.ConfigMutex.Lock()
-			cluster.Clus.Logs.Append(node, value)
+			cluster.Clus.Logs.Append(node, line)
 			cluster.Clus.ConfigMutex.Unlock()
-			fallthrough
-		case "TARGETQPSAT":
-			fallthrough
-		case "PROCSAT":
-			message := map[string]interface{}{
-				"type":  cmd,
-				"node":  node,
-				"value": value,
+			value = line
+		case "BULKSET", "READSSET", "WRITECONCERNSET", "ARMED", "SKEW", "GUARDRAILSSET", "ABORTED":
+			var setting string
+			msg.Decode(&setting)
+			value = setting
+		case "TARGETQPSAT", "CLUSTERQPSAT", "PROCSAT":
+			var n int
+			msg.Decode(&n)
+			cluster.Clus.ConfigMutex.Lock()
+			switch cmd {
+			case "TARGETQPSAT":
+				cluster.Clus.Targets[node] = n
+			case "PROCSAT":
+				cluster.Clus.Procs[node] = n
 			}
-			cluster.WS.WriteJSON(message)
+			cluster.Clus.ConfigMutex.Unlock()
+			value = n
+		case "DBTARGETSSET":
+			var version int64
+			msg.Decode(&version)
+			value = version
 		case "QPS":
-			items := strings.Split(cmds[2], " ")
-			qps, err := strconv.ParseUint(items[0], 10, 64)
-			if err != nil {
-				cluster.Log("Error converting qps: %s", err)
-				return
-			}
-			date, err := strconv.ParseUint(items[1], 10, 64)
-			if err != nil {
-				cluster.Log("Error converting date: %s", err)
-				return
-			}
-			datapoint := []uint64{date, qps} // date, QPS
+			var qps [2]uint64 // QPS, date
+			msg.Decode(&qps)
+			datapoint := []uint64{qps[1], qps[0]} // date, QPS
 			cluster.Clus.ConfigMutex.Lock()
 			cluster.Clus.Qps.Append(node, datapoint)
 			cluster.Clus.ConfigMutex.Unlock()
-			// Send to any listeners
-			message := map[string]interface{}{
-				"type":  "QPS",
-				"node":  node,
-				"value": datapoint,
-			}
-			cluster.WS.WriteJSON(message)
+			value = datapoint
+		case "LATENCY":
+			var report engine.LatencyReport
+			msg.Decode(&report)
+			consumeLatency(node, report)
+			continue
+		case "REPLAYS":
+			var counts map[string]engine.ReplayCount
+			msg.Decode(&counts)
+			consumeReplays(node, counts)
+			continue
+		case "READSTATS":
+			var reads engine.ReadStats
+			msg.Decode(&reads)
+			nodeReadsMutex.Lock()
+			nodeReads[node] = reads
+			nodeReadsMutex.Unlock()
+			value = reads
+		case "ERRORS":
+			var counts stats.Counts
+			msg.Decode(&counts)
+			cluster.Clus.ConfigMutex.Lock()
+			cluster.Clus.Errors[node] = counts
+			cluster.Clus.ConfigMutex.Unlock()
+			value = counts
+		default:
+			continue
+		}
+		if value != nil {
+			message["value"] = value
 		}
+		cluster.WS.WriteJSON(message)
 	}
 }
 
@@ -167,18 +215,32 @@ func rewrite(to string, handler http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
-func UI() {
+// Handler routes every page, API and the websocket, each behind the
+// role it needs.
+func Handler() http.Handler {
 	handler := http.NewServeMux()
 	assetHandler := ServeHome()
 	handler.HandleFunc("/amazon_health/", AmazonHealth)
-	handler.HandleFunc("/state/", ClusterState)
-	handler.HandleFunc("/ws", cluster.WS.ServeWs)
+	handler.HandleFunc("/state/", guard(cluster.Viewer, ClusterState))
+	handler.HandleFunc("/metrics", guard(cluster.Viewer, Metrics))
+	handler.HandleFunc("/profile/", guard(cluster.Operator, Profile))
+	handler.HandleFunc("/search/", guard(cluster.Operator, Search))
+	handler.HandleFunc("/schedule/", guard(cluster.Operator, Schedule))
+	handler.HandleFunc("/runs/", guard(cluster.Operator, Runs))
+	handler.HandleFunc("/verify/", guard(cluster.Operator, Verify))
+	handler.HandleFunc("/dbtargets/", guard(cluster.Admin, DBTargets))
+	handler.HandleFunc("/gossipkeys/", guard(cluster.Admin, GossipKeys))
+	handler.HandleFunc("/ws", guard(cluster.Viewer, serveWs))
+	handler.HandleFunc("/api/v1/", guard(cluster.Viewer, API))
 	handler.HandleFunc("/favicon.ico", rewrite("assets/ico/favicon.ico", assetHandler))
-	handler.HandleFunc("/", assetHandler)
+	handler.HandleFunc("/", guard(cluster.Viewer, assetHandler))
+	return handler
+}
 
+func UI() {
 	go StatsMessageConsumer()
 	fmt.Printf("Serving on port %d\n", common.WEBPORT)
-	err := manners.ListenAndServe(fmt.Sprintf(":%d", common.WEBPORT), handler)
+	err := manners.ListenAndServe(fmt.Sprintf(":%d", common.WEBPORT), Handler())
 	if err != nil {
 		panic(err)
 	}
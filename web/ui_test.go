@@ -1,17 +1,24 @@
 package web_test
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/awstesting/mock"
 	"github.com/bouk/monkey"
+	"github.com/gorilla/websocket"
 	. "github.com/lyfe-mobile/hitter/cluster"
 	"github.com/lyfe-mobile/hitter/engine"
 	"github.com/lyfe-mobile/hitter/web"
@@ -45,7 +52,7 @@ var _ = Describe("Engine", func() {
 		monkey.UnpatchAll()
 	})
 
-	FIt("UI receives qps and circularly buffers it", func() {
+	It("UI receives qps and circularly buffers it", func() {
 		for i := 1; i < 109; i++ {
 			m.SendUI("QPS", []uint64{uint64(1000 * i), uint64(time.Now().Unix()) * 1000})
 		}
@@ -63,6 +70,253 @@ var _ = Describe("Engine", func() {
 	})
 })
 
+var _ = Describe("HTTP", func() {
+	var (
+		ts      *TestServer
+		server  *httptest.Server
+		path    string
+		drained chan bool
+	)
+	// do makes a request as user (a name and password, or a token
+	// starting "token:"), or as no one if user is "", and gives the
+	// response and its body.
+	do := func(method, url, user, body string) (*http.Response, string) {
+		req, err := http.NewRequest(method, server.URL+url, strings.NewReader(body))
+		Ω(err).ShouldNot(HaveOccurred())
+		if strings.HasPrefix(user, "token:") {
+			req.Header.Set("Authorization", "Bearer "+strings.TrimPrefix(user, "token:"))
+		} else if user != "" {
+			req.SetBasicAuth(user, user+"pw")
+		}
+		resp, err := http.DefaultClient.Do(req)
+		Ω(err).ShouldNot(HaveOccurred())
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		Ω(err).ShouldNot(HaveOccurred())
+		return resp, string(b)
+	}
+	status := func(method, url, user string) int {
+		resp, _ := do(method, url, user, "")
+		return resp.StatusCode
+	}
+	writeUsers := func(list string) {
+		Ω(ioutil.WriteFile(path, []byte(list), 0600)).Should(Succeed())
+	}
+	BeforeEach(func() {
+		ec2metadata := ec2metadata.New(mock.Session)
+		monkey.PatchInstanceMethod(reflect.TypeOf(ec2metadata), "GetMetadata", FakeEC2Metadata)
+		ts = StartTestServer(tempDir)
+		go engine.Engine()
+		drained = make(chan bool)
+		go func() { // What the engine tells the UI
+			for range Clus.UIMsgs {
+			}
+			close(drained)
+		}()
+		server = httptest.NewServer(web.Handler())
+
+		path = filepath.Join(tempDir, "users.json")
+		var hashes []string
+		for _, name := range []string{"viewer", "operator", "admin"} {
+			hash, err := web.HashPassword(name + "pw")
+			Ω(err).ShouldNot(HaveOccurred())
+			hashes = append(hashes, hash)
+		}
+		writeUsers(fmt.Sprintf(`[
+			{"name": "viewer", "password": %q, "role": "viewer"},
+			{"name": "operator", "password": %q, "token": "optoken", "role": "operator"},
+			{"name": "admin", "password": %q, "role": "admin"}
+		]`, hashes[0], hashes[1], hashes[2]))
+		Ω(web.LoadUsers(path)).Should(Succeed())
+	})
+	AfterEach(func() {
+		web.OpenLogins()
+		server.Close()
+		Clus.SendEngine("EXIT")
+		Eventually(drained).Should(BeClosed())
+		ts.Stop()
+		monkey.UnpatchAll()
+	})
+
+	Describe("Logins", func() {
+		It("turns away those who haven't logged in", func() {
+			resp, _ := do("GET", "/state/", "", "")
+			Ω(resp.StatusCode).Should(Equal(http.StatusUnauthorized))
+			Ω(resp.Header.Get("WWW-Authenticate")).Should(ContainSubstring("Basic"))
+			Ω(status("GET", "/state/", "token:nope")).Should(Equal(http.StatusUnauthorized))
+			Ω(status("DELETE", "/schedule/", "")).Should(Equal(http.StatusUnauthorized))
+			Ω(status("GET", "/api/v1/cluster", "")).Should(Equal(http.StatusUnauthorized))
+			Ω(status("GET", "/amazon_health/", "")).Should(Equal(http.StatusOK)) // For the load balancer
+
+			req, _ := http.NewRequest("GET", server.URL+"/state/", nil)
+			req.SetBasicAuth("viewer", "wrong")
+			resp, err := http.DefaultClient.Do(req)
+			Ω(err).ShouldNot(HaveOccurred())
+			resp.Body.Close()
+			Ω(resp.StatusCode).Should(Equal(http.StatusUnauthorized))
+		})
+		It("lets each role do what it may", func() {
+			Ω(status("GET", "/state/", "viewer")).Should(Equal(http.StatusOK))
+			Ω(status("DELETE", "/schedule/", "viewer")).Should(Equal(http.StatusForbidden))
+			Ω(status("DELETE", "/schedule/", "operator")).Should(Equal(http.StatusAccepted))
+			Ω(status("DELETE", "/schedule/", "token:optoken")).Should(Equal(http.StatusAccepted))
+			Ω(status("POST", "/dbtargets/", "operator")).Should(Equal(http.StatusForbidden))
+			Ω(status("GET", "/dbtargets/", "operator")).Should(Equal(http.StatusOK))
+			Ω(status("DELETE", "/schedule/", "admin")).Should(Equal(http.StatusAccepted))
+		})
+		It("guards the websocket", func() {
+			url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+			_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+			Ω(err).Should(HaveOccurred())
+			Ω(resp.StatusCode).Should(Equal(http.StatusUnauthorized))
+			socket, _, err := websocket.DefaultDialer.Dial(url+"?token=optoken", nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			socket.Close()
+			header := http.Header{}
+			header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("viewer:viewerpw")))
+			socket, _, err = websocket.DefaultDialer.Dial(url, header)
+			Ω(err).ShouldNot(HaveOccurred())
+			socket.Close()
+		})
+		It("sends what the UI asks for as its own message", func() {
+			url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token=optoken"
+			socket, _, err := websocket.DefaultDialer.Dial(url, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			defer socket.Close()
+			// next gives the next message of type typ the UI is sent.
+			next := func(typ string) (msg map[string]interface{}) {
+				for msg["type"] != typ {
+					msg = nil
+					Ω(socket.ReadJSON(&msg)).Should(Succeed())
+				}
+				return msg
+			}
+			Ω(socket.WriteJSON(map[string]interface{}{"v": 1, "type": "TARGETQPS", "id": "ui-1", "payload": 100})).Should(Succeed())
+			id := next("DELIVERY")["value"].(map[string]interface{})["id"]
+			Ω(id).ShouldNot(Equal("ui-1"))
+			Ω(id).Should(MatchRegexp("^" + Clus.Name + "-"))
+			_, ok := Clus.Delivery("ui-1")
+			Ω(ok).Should(BeFalse())
+
+			Ω(socket.WriteJSON(map[string]interface{}{"v": 1, "type": "DIE", "id": "ui-2"})).Should(Succeed())
+			Ω(next("ERROR")["id"]).Should(Equal("ui-2"))
+		})
+		It("checks each login's password once", func() {
+			req, _ := http.NewRequest("GET", "/", nil)
+			req.SetBasicAuth("admin", "adminpw")
+			u, err := web.Authenticate(req)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(u.Name).Should(Equal("admin"))
+			Ω(web.RoleOf(req)).Should(Equal(Admin))
+			u, err = web.Authenticate(req) // From the cache this time
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(u.Name).Should(Equal("admin"))
+
+			By("forgetting them when the users change")
+			writeUsers(`[{"name": "admin", "token": "admintoken", "role": "admin"}]`)
+			Ω(web.LoadUsers(path)).Should(Succeed())
+			_, err = web.Authenticate(req)
+			Ω(err).Should(MatchError("Wrong user name or password"))
+			Ω(web.RoleOf(req)).Should(Equal(NoRole))
+
+			By("letting everyone in without users")
+			web.OpenLogins()
+			Ω(web.RoleOf(req)).Should(Equal(Admin))
+			Ω(status("POST", "/dbtargets/", "")).ShouldNot(Equal(http.StatusUnauthorized))
+		})
+		It("refuses bad users files", func() {
+			for list, want := range map[string]string{
+				`[]`: "no users",
+				`[{"name": "a", "token": "t", "role": "viewer"}, {"name": "a", "token": "u", "role": "viewer"}]`: `repeated user name "a"`,
+				`[{"name": "a", "role": "viewer"}]`:             "needs a password or a token",
+				`[{"name": "a", "token": "t", "role": "boss"}]`: `Unknown role "boss"`,
+				`{"name": "a", "token": "t", "role": "viewer"}`: "cannot unmarshal",
+			} {
+				writeUsers(list)
+				Ω(web.LoadUsers(path)).Should(MatchError(ContainSubstring(want)))
+			}
+			Ω(web.LoadUsers(filepath.Join(tempDir, "nosuchfile"))).ShouldNot(Succeed())
+			Ω(status("GET", "/state/", "viewer")).Should(Equal(http.StatusOK)) // Still the old users
+		})
+	})
+
+	Describe("API", func() {
+		BeforeEach(func() {
+			engine.RunDir = filepath.Join(tempDir, "apiruns")
+			Ω(engine.SaveRun(&engine.RunRecord{ID: "run1", Start: time.Unix(1000, 0)})).Should(Succeed())
+		})
+		AfterEach(func() {
+			os.RemoveAll(engine.RunDir)
+		})
+		It("gives the cluster, its nodes and runs", func() {
+			resp, body := do("GET", "/api/v1/cluster", "viewer", "")
+			Ω(resp.StatusCode).Should(Equal(http.StatusOK))
+			Ω(resp.Header.Get("Content-Type")).Should(Equal("application/json"))
+			Ω(body).Should(ContainSubstring(`"name":"mainproc"`))
+
+			resp, body = do("GET", "/api/v1/nodes", "viewer", "")
+			Ω(resp.StatusCode).Should(Equal(http.StatusOK))
+			var nodes []map[string]interface{}
+			Ω(json.Unmarshal([]byte(body), &nodes)).Should(Succeed())
+			Ω(nodes).Should(HaveLen(1))
+			Ω(nodes[0]["name"]).Should(Equal("mainproc"))
+			_, body = do("GET", "/api/v1/nodes/mainproc", "viewer", "")
+			Ω(body).Should(MatchRegexp(`^\{.*"name":"mainproc"`))
+
+			_, body = do("GET", "/api/v1/runs", "viewer", "")
+			Ω(body).Should(MatchRegexp(`^\[\{"id":"run1"`))
+			resp, body = do("GET", "/api/v1/runs/run1", "viewer", "")
+			Ω(resp.StatusCode).Should(Equal(http.StatusOK))
+			Ω(body).Should(ContainSubstring(`"id":"run1"`))
+		})
+		It("says what isn't there", func() {
+			resp, body := do("GET", "/api/v1/nodes/ghost", "viewer", "")
+			Ω(resp.StatusCode).Should(Equal(http.StatusNotFound))
+			Ω(body).Should(MatchJSON(`{"error": "No node ghost"}`))
+			Ω(status("GET", "/api/v1/runs/nope", "viewer")).Should(Equal(http.StatusNotFound))
+			Ω(status("GET", "/api/v1/frob", "viewer")).Should(Equal(http.StatusNotFound))
+			Ω(status("GET", "/api/v1/commands", "viewer")).Should(Equal(http.StatusNotFound))
+			Ω(status("PUT", "/api/v1/cluster", "admin")).Should(Equal(http.StatusMethodNotAllowed))
+		})
+		It("runs commands and says how each node took them", func() {
+			resp, body := do("POST", "/api/v1/commands", "operator", `{"type": "TARGETQPS", "payload": 500}`)
+			Ω(resp.StatusCode).Should(Equal(http.StatusOK))
+			var result web.CommandResult
+			Ω(json.Unmarshal([]byte(body), &result)).Should(Succeed())
+			Ω(result.OK).Should(BeTrue())
+			Ω(result.Target).Should(Equal("*"))
+			Ω(result.Nodes).Should(HaveLen(1))
+			Ω(result.Nodes["mainproc"].State).Should(Equal("done"))
+			Eventually(func() int { return PERSEC }).Should(Equal(500))
+
+			_, body = do("POST", "/api/v1/nodes/mainproc/db", "admin", `"nowhere"`)
+			result = web.CommandResult{}
+			Ω(json.Unmarshal([]byte(body), &result)).Should(Succeed())
+			Ω(result.OK).Should(BeFalse())
+			Ω(result.Nodes["mainproc"].State).Should(Equal("refused"))
+			Ω(result.Nodes["mainproc"].Error).Should(Equal("No DB target nowhere"))
+
+			_, body = do("POST", "/api/v1/nodes/ghost/stop", "operator", "")
+			result = web.CommandResult{}
+			Ω(json.Unmarshal([]byte(body), &result)).Should(Succeed())
+			Ω(result.OK).Should(BeFalse())
+			Ω(result.Nodes["ghost"].State).Should(Equal("failed"))
+		})
+		It("checks who sends commands, and what", func() {
+			resp, body := do("POST", "/api/v1/nodes/*/stop", "viewer", "")
+			Ω(resp.StatusCode).Should(Equal(http.StatusForbidden))
+			Ω(body).Should(MatchJSON(`{"error": "STOP needs the operator role"}`))
+			Ω(status("POST", "/api/v1/nodes/*/db", "operator")).Should(Equal(http.StatusForbidden))
+			Ω(status("POST", "/api/v1/commands", "")).Should(Equal(http.StatusUnauthorized))
+			Ω(status("POST", "/api/v1/nodes/*/targetqps", "operator")).Should(Equal(http.StatusBadRequest)) // No payload
+			Ω(status("POST", "/api/v1/commands", "admin")).Should(Equal(http.StatusBadRequest))             // No body
+			resp, body = do("POST", "/api/v1/nodes/*/started", "admin", "")
+			Ω(resp.StatusCode).Should(Equal(http.StatusBadRequest))
+			Ω(body).Should(MatchJSON(`{"error": "STARTED isn't a command"}`))
+		})
+	})
+})
+
 // Ginkgo boilerplate, this runs all tests in this package
 func TestSuite(t *testing.T) {
 	RegisterFailHandler(Fail)
@@ -0,0 +1,124 @@
+package web
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/lyfe-mobile/hitter/cluster"
+	"github.com/lyfe-mobile/hitter/engine"
+)
+
+var (
+	verifyMutex    sync.Mutex
+	verifyBaseline *engine.Baseline
+	lastVerify     *engine.VerifyReport
+	nodeReplays    = map[string]map[string]engine.ReplayCount{} // From REPLAYS messages
+)
+
+func consumeReplays(node string, counts map[string]engine.ReplayCount) {
+	verifyMutex.Lock()
+	nodeReplays[node] = counts
+	verifyMutex.Unlock()
+}
+
+// Verify checks the database against the logs every node has
+// replayed. With every node stopped:
+//
+//	POST /verify/baseline  resets replay counts and takes a baseline
+//	POST /verify/          checks the database and reports
+//	GET /verify/           gives the last report as JSON
+//	GET /verify/<coll>.csv gives a collection's mismatches as CSV
+func Verify(w http.ResponseWriter, r *http.Request) {
+	what := strings.TrimPrefix(r.URL.Path, "/verify/")
+	switch {
+	case r.Method == "POST" && what == "baseline":
+		cluster.Clus.SendEngine("VERIFYRESET")
+		baseline, err := engine.TakeBaseline()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		verifyMutex.Lock()
+		verifyBaseline = baseline
+		nodeReplays = map[string]map[string]engine.ReplayCount{}
+		verifyMutex.Unlock()
+		cluster.Log("Took a verification baseline")
+		writeJSON(w, map[string]interface{}{"time": baseline.Time})
+	case r.Method == "POST":
+		verifyMutex.Lock()
+		baseline := verifyBaseline
+		var counts []map[string]engine.ReplayCount
+		for _, member := range cluster.Clus.Members.Members() {
+			counts = append(counts, nodeReplays[member.Name])
+		}
+		verifyMutex.Unlock()
+		report, err := engine.Verify(baseline, engine.SumReplays(counts...))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		verifyMutex.Lock()
+		lastVerify = report
+		verifyMutex.Unlock()
+		sendVerified(report)
+		writeJSON(w, report)
+	default:
+		verifyMutex.Lock()
+		report := lastVerify
+		verifyMutex.Unlock()
+		if report == nil {
+			http.Error(w, "Nothing verified yet", http.StatusNotFound)
+			return
+		}
+		if what == "" {
+			writeJSON(w, report)
+			return
+		}
+		coll := strings.TrimSuffix(what, ".csv")
+		cr, ok := report.Collections[coll]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q",
+			fmt.Sprintf("verify-%s-%s.csv", coll, report.Time.UTC().Format("20060102T150405"))))
+		out := csv.NewWriter(w)
+		out.Write([]string{"key", "field", "kind", "expected", "expected_max", "actual"})
+		for _, m := range cr.Mismatches {
+			out.Write([]string{m.Key, m.Field, m.Kind, fmt.Sprint(m.Expected), fmt.Sprint(m.ExpectedMax), fmt.Sprint(m.Actual)})
+		}
+		out.Flush()
+	}
+}
+
+// Tell this node's UI how verification went, without the mismatches.
+func sendVerified(report *engine.VerifyReport) {
+	summary := map[string]interface{}{}
+	for coll, cr := range report.Collections {
+		summary[coll] = map[string]int{
+			"checked": cr.Checked, "matched": cr.Matched, "missing": cr.Missing, "low": cr.Low, "high": cr.High,
+		}
+	}
+	cluster.WS.WriteJSON(map[string]interface{}{
+		"type":     "VERIFIED",
+		"ok":       report.OK(),
+		"baseline": report.Baseline != nil,
+		"value":    summary,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		cluster.Log("Marshaling JSON: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}